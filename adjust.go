@@ -2,57 +2,76 @@
 
 package tushare
 
-import "time"
+import (
+	"context"
+	"iter"
+	"time"
+)
 
 // Adjust 复权数据
 type Adjust struct {
-	Code   string    // 股票代码
-	Date   time.Time // 日期
-	Factor float64   // 复权因子
+	Columns `json:"-"`
+	Code    string    `tushare:"ts_code"`         // 股票代码
+	Date    time.Time `tushare:"trade_date,date"` // 日期
+	Factor  float64   `tushare:"adj_factor"`      // 复权因子
 }
 
 type adjustOpt func(Args)
 
-func (cli *Client) adjFactor(api string, opts ...adjustOpt) ([]Adjust, error) {
+var adjustFields = []string{"ts_code", "trade_date", "adj_factor"}
+
+func (cli *Client) adjFactor(ctx context.Context, api string, opts ...adjustOpt) ([]Adjust, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call(api, args, []string{"ts_code", "trade_date", "adj_factor"})
-	if err != nil {
-		return nil, err
-	}
-	var idxCode, idxDate, idxFactor int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "trade_date":
-			idxDate = i
-		case "adj_factor":
-			idxFactor = i
-		}
-	}
-	items := make([]Adjust, len(data))
-	for i, item := range data {
-		date, _ := time.ParseInLocation("20060102", item[idxDate].(string), time.Local)
-		items[i] = Adjust{
-			Code:   item[idxCode].(string),
-			Date:   date,
-			Factor: item[idxFactor].(float64),
-		}
-	}
-	return items, nil
+	return query[Adjust](ctx, cli, api, args, adjustFields)
 }
 
 // AdjFactor 获取复权数据
 func (cli *Client) AdjFactor(opts ...adjustOpt) ([]Adjust, error) {
-	return cli.adjFactor("adj_factor", opts...)
+	return cli.AdjFactorContext(context.Background(), opts...)
+}
+
+// AdjFactorContext 获取复权数据
+func (cli *Client) AdjFactorContext(ctx context.Context, opts ...adjustOpt) ([]Adjust, error) {
+	return cli.adjFactor(ctx, "adj_factor", opts...)
 }
 
 // AdjFactorVip 获取VIP复权数据
 func (cli *Client) AdjFactorVip(opts ...adjustOpt) ([]Adjust, error) {
-	return cli.adjFactor("adj_factor_vip", opts...)
+	return cli.AdjFactorVipContext(context.Background(), opts...)
+}
+
+// AdjFactorVipContext 获取VIP复权数据
+func (cli *Client) AdjFactorVipContext(ctx context.Context, opts ...adjustOpt) ([]Adjust, error) {
+	return cli.adjFactor(ctx, "adj_factor_vip", opts...)
+}
+
+// https://tushare.pro/document/2?doc_id=199
+
+// FundAdj 获取基金复权因子
+func (cli *Client) FundAdj(opts ...adjustOpt) ([]Adjust, error) {
+	return cli.FundAdjContext(context.Background(), opts...)
+}
+
+// FundAdjContext 获取基金复权因子
+func (cli *Client) FundAdjContext(ctx context.Context, opts ...adjustOpt) ([]Adjust, error) {
+	return cli.adjFactor(ctx, "fund_adj", opts...)
+}
+
+// AdjFactorIter 逐行获取复权数据，按页请求，停止迭代时不再请求后续数据
+func (cli *Client) AdjFactorIter(opts ...adjustOpt) iter.Seq2[Adjust, error] {
+	return cli.AdjFactorIterContext(context.Background(), opts...)
+}
+
+// AdjFactorIterContext 逐行获取复权数据，按页请求，停止迭代时不再请求后续数据
+func (cli *Client) AdjFactorIterContext(ctx context.Context, opts ...adjustOpt) iter.Seq2[Adjust, error] {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return iterRows[Adjust](ctx, cli, "adj_factor", args, adjustFields)
 }
 
 // WithAdjustCode 设置股票代码参数
@@ -65,14 +84,14 @@ func WithAdjustCode(code string) adjustOpt {
 // WithAdjustDate 设置交易日期参数
 func WithAdjustDate(date time.Time) adjustOpt {
 	return func(args Args) {
-		args["trade_date"] = date.Format("20060102")
+		args["trade_date"] = date
 	}
 }
 
 // WithAdjustDateRange 设置日期范围参数
 func WithAdjustDateRange(start, end time.Time) adjustOpt {
 	return func(args Args) {
-		args["start_date"] = start.Format("20060102")
-		args["end_date"] = end.Format("20060102")
+		args["start_date"] = start
+		args["end_date"] = end
 	}
 }
@@ -0,0 +1,144 @@
+package tushare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+type adjustMode string
+
+const (
+	AdjustNone     adjustMode = ""    // 不复权
+	AdjustForward  adjustMode = "qfq" // 前复权
+	AdjustBackward adjustMode = "hfq" // 后复权
+)
+
+// AdjustedDaily 获取复权后的股票日线数据，需要复权但没有复权因子时返回ErrNoAdjFactor
+func (cli *Client) AdjustedDaily(code string, start, end time.Time, mode adjustMode) ([]DailyTick, error) {
+	return cli.AdjustedDailyContext(context.Background(), code, start, end, mode)
+}
+
+// AdjustedDailyContext 获取复权后的股票日线数据，需要复权但没有复权因子时返回ErrNoAdjFactor
+func (cli *Client) AdjustedDailyContext(ctx context.Context, code string, start, end time.Time, mode adjustMode) ([]DailyTick, error) {
+	return cli.adjustedDaily(ctx, "daily", "adj_factor", 2, code, start, end, mode)
+}
+
+// AdjustedFundDaily 获取复权后的场内基金日线数据，需要复权但没有复权因子时返回ErrNoAdjFactor
+func (cli *Client) AdjustedFundDaily(code string, start, end time.Time, mode adjustMode) ([]DailyTick, error) {
+	return cli.AdjustedFundDailyContext(context.Background(), code, start, end, mode)
+}
+
+// AdjustedFundDailyContext 获取复权后的场内基金日线数据，需要复权但没有复权因子时返回ErrNoAdjFactor
+func (cli *Client) AdjustedFundDailyContext(ctx context.Context, code string, start, end time.Time, mode adjustMode) ([]DailyTick, error) {
+	return cli.adjustedDaily(ctx, "fund_daily", "fund_adj", 3, code, start, end, mode)
+}
+
+func (cli *Client) adjustedDaily(ctx context.Context, dailyAPI, adjAPI string, digits int,
+	code string, start, end time.Time, mode adjustMode) ([]DailyTick, error) {
+	ticks, err := cli.daily(ctx, dailyAPI, WithDailyCode(code), WithDailyDateRange(start, end))
+	if err != nil {
+		return nil, err
+	}
+	if mode == AdjustNone || len(ticks) == 0 {
+		return ticks, nil
+	}
+	factors, err := cli.codeFactors(ctx, adjAPI, code, WithAdjustDateRange(start, end))
+	if err != nil {
+		return nil, err
+	}
+	return adjust(ticks, factors, mode, digits), nil
+}
+
+// ErrNoAdjFactor 需要复权时没有获取到复权因子
+var ErrNoAdjFactor = errors.New("tushare: no adjust factor")
+
+// codeFactors 获取code的复权因子，没有复权因子时返回ErrNoAdjFactor，而不是返回未复权的价格
+func (cli *Client) codeFactors(ctx context.Context, api, code string, opts ...adjustOpt) ([]Adjust, error) {
+	factors, err := cli.adjFactor(ctx, api, append(slices.Clip(opts), WithAdjustCode(code))...)
+	if err != nil {
+		return nil, err
+	}
+	if len(factors) == 0 {
+		return nil, fmt.Errorf("%s %s: %w", api, code, ErrNoAdjFactor)
+	}
+	return factors, nil
+}
+
+// Adjusted 使用复权因子对日线数据进行复权，结果按代码及日期升序排列，
+// 与pro_bar相同，开高低收及昨收价乘以复权因子(前复权时再除以区间内最新的复权因子)后保留两位小数，
+// 涨跌额及涨跌幅按复权后的价格重新计算，涨跌幅保留两位小数，成交量及成交额不变，
+// 缺少复权因子的交易日使用之前最近的复权因子，之前没有时使用之后最近的复权因子
+func Adjusted(ticks []DailyTick, factors []Adjust, mode adjustMode) []DailyTick {
+	return adjust(ticks, factors, mode, 2)
+}
+
+func adjust(ticks []DailyTick, factors []Adjust, mode adjustMode, digits int) []DailyTick {
+	ret := slices.Clone(ticks)
+	slices.SortFunc(ret, func(a, b DailyTick) int {
+		if a.Code != b.Code {
+			if a.Code < b.Code {
+				return -1
+			}
+			return 1
+		}
+		return a.Time.Compare(b.Time)
+	})
+	if mode == AdjustNone {
+		return ret
+	}
+	byCode := make(map[string][]Adjust)
+	for _, f := range factors {
+		byCode[f.Code] = append(byCode[f.Code], f)
+	}
+	for _, fs := range byCode {
+		slices.SortFunc(fs, func(a, b Adjust) int {
+			return a.Date.Compare(b.Date)
+		})
+	}
+	for i := 0; i < len(ret); {
+		j := i
+		for j < len(ret) && ret[j].Code == ret[i].Code {
+			j++
+		}
+		adjustCode(ret[i:j], byCode[ret[i].Code], mode, digits)
+		i = j
+	}
+	return ret
+}
+
+// adjustCode 对同一代码按日期升序排列的数据进行复权
+func adjustCode(ticks []DailyTick, factors []Adjust, mode adjustMode, digits int) {
+	if len(factors) == 0 {
+		return
+	}
+	latest := factors[len(factors)-1].Factor
+	var k int
+	for i := range ticks {
+		for k+1 < len(factors) && !factors[k+1].Date.After(ticks[i].Time) {
+			k++
+		}
+		f := factors[k].Factor
+		if mode == AdjustForward {
+			f /= latest
+		}
+		t := &ticks[i]
+		t.Open = round(t.Open*f, digits)
+		t.High = round(t.High*f, digits)
+		t.Low = round(t.Low*f, digits)
+		t.Close = round(t.Close*f, digits)
+		t.PreClose = round(t.PreClose*f, digits)
+		t.Change = round(t.Close-t.PreClose, digits)
+		if t.PreClose != 0 {
+			t.PctChg = round(t.Change/t.PreClose*100, 2)
+		}
+	}
+}
+
+func round(v float64, digits int) float64 {
+	p := math.Pow10(digits)
+	return math.Round(v*p) / p
+}
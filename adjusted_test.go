@@ -0,0 +1,126 @@
+package tushare
+
+import (
+	"testing"
+	"time"
+)
+
+func testDay(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, Shanghai)
+}
+
+func testTick(code string, date time.Time, open, high, low, close, preClose float64) DailyTick {
+	var t DailyTick
+	t.Code, t.Time = code, date
+	t.Open, t.High, t.Low, t.Close, t.PreClose = open, high, low, close, preClose
+	t.Volume, t.Turnover = 1000, 1000
+	return t
+}
+
+// proBarTicks 000001.SZ在2023-06-14除息前后的不复权行情
+func proBarTicks() ([]DailyTick, []Adjust) {
+	ticks := []DailyTick{
+		testTick("000001.SZ", testDay(2023, 6, 15), 11.22, 11.40, 11.20, 11.36, 11.22),
+		testTick("000001.SZ", testDay(2023, 6, 14), 11.25, 11.33, 11.16, 11.22, 11.21),
+		testTick("000001.SZ", testDay(2023, 6, 13), 11.31, 11.52, 11.28, 11.49, 11.30),
+		testTick("000001.SZ", testDay(2023, 6, 12), 11.20, 11.35, 11.15, 11.30, 11.22),
+	}
+	factors := []Adjust{
+		{Code: "000001.SZ", Date: testDay(2023, 6, 15), Factor: 108.031},
+		{Code: "000001.SZ", Date: testDay(2023, 6, 14), Factor: 108.031},
+		{Code: "000001.SZ", Date: testDay(2023, 6, 13), Factor: 105.42},
+		{Code: "000001.SZ", Date: testDay(2023, 6, 12), Factor: 105.42},
+	}
+	return ticks, factors
+}
+
+type ohlc struct {
+	open, high, low, close, preClose, change, pctChg float64
+}
+
+func checkOHLC(t *testing.T, got []DailyTick, want []ohlc) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if (ohlc{g.Open, g.High, g.Low, g.Close, g.PreClose, g.Change, g.PctChg}) != w {
+			t.Errorf("row %d (%s): got %v, want %+v", i, g.Time.Format("20060102"),
+				ohlc{g.Open, g.High, g.Low, g.Close, g.PreClose, g.Change, g.PctChg}, w)
+		}
+	}
+}
+
+// 期望值按pro_bar的算法计算：价格乘以复权因子(前复权时再除以最新的复权因子)后按%.2f格式化，
+// change=close-pre_close，pct_chg=change/pre_close*100后按%.2f格式化
+func TestAdjustedMatchesProBar(t *testing.T) {
+	ticks, factors := proBarTicks()
+	checkOHLC(t, Adjusted(ticks, factors, AdjustForward), []ohlc{
+		{10.93, 11.08, 10.88, 11.03, 10.95, 0.08, 0.73},
+		{11.04, 11.24, 11.01, 11.21, 11.03, 0.18, 1.63},
+		{11.25, 11.33, 11.16, 11.22, 11.21, 0.01, 0.09},
+		{11.22, 11.4, 11.2, 11.36, 11.22, 0.14, 1.25},
+	})
+	checkOHLC(t, Adjusted(ticks, factors, AdjustBackward), []ohlc{
+		{1180.7, 1196.52, 1175.43, 1191.25, 1182.81, 8.44, 0.71},
+		{1192.3, 1214.44, 1189.14, 1211.28, 1191.25, 20.03, 1.68},
+		{1215.35, 1223.99, 1205.63, 1212.11, 1211.03, 1.08, 0.09},
+		{1212.11, 1231.55, 1209.95, 1227.23, 1212.11, 15.12, 1.25},
+	})
+}
+
+func TestAdjustedForwardUsesLatestFactor(t *testing.T) {
+	ticks := []DailyTick{
+		testTick("A", testDay(2024, 1, 2), 10, 10, 10, 10, 10),
+		testTick("A", testDay(2024, 1, 3), 10, 10, 10, 10, 10),
+	}
+	factors := []Adjust{
+		{Code: "A", Date: testDay(2024, 1, 2), Factor: 1},
+		{Code: "A", Date: testDay(2024, 1, 3), Factor: 4},
+	}
+	got := Adjusted(ticks, factors, AdjustForward)
+	// 区间内最新的复权因子为4，最新一天的价格不变
+	if got[0].Close != 2.5 || got[1].Close != 10 {
+		t.Fatalf("closes = %v, %v, want 2.5, 10", got[0].Close, got[1].Close)
+	}
+}
+
+func TestAdjustedMissingFactor(t *testing.T) {
+	var ticks []DailyTick
+	for d := 2; d <= 5; d++ {
+		ticks = append(ticks, testTick("A", testDay(2024, 1, d), 10, 10, 10, 10, 10))
+	}
+	// 缺少1月2日及1月4日的复权因子
+	factors := []Adjust{
+		{Code: "A", Date: testDay(2024, 1, 3), Factor: 2},
+		{Code: "A", Date: testDay(2024, 1, 5), Factor: 3},
+	}
+	got := Adjusted(ticks, factors, AdjustBackward)
+	// 1月2日之前没有复权因子，使用之后最近的；1月4日使用之前最近的
+	want := []float64{20, 20, 20, 30}
+	for i, w := range want {
+		if got[i].Close != w {
+			t.Errorf("%s: close = %v, want %v", got[i].Time.Format("20060102"), got[i].Close, w)
+		}
+	}
+}
+
+func TestAdjustedFundDigits(t *testing.T) {
+	ticks := []DailyTick{testTick("510300.SH", testDay(2024, 1, 2), 1.234, 1.234, 1.234, 1.234, 1.2)}
+	factors := []Adjust{{Code: "510300.SH", Date: testDay(2024, 1, 2), Factor: 1.1}}
+	if got := adjust(ticks, factors, AdjustBackward, 3); got[0].Close != 1.357 {
+		t.Fatalf("fund close = %v, want 1.357", got[0].Close)
+	}
+	if got := Adjusted(ticks, factors, AdjustBackward); got[0].Close != 1.36 {
+		t.Fatalf("stock close = %v, want 1.36", got[0].Close)
+	}
+}
+
+func TestAdjustedNone(t *testing.T) {
+	ticks, factors := proBarTicks()
+	got := Adjusted(ticks, factors, AdjustNone)
+	if got[0].Close != 11.30 || got[3].Close != 11.36 {
+		t.Fatalf("AdjustNone changed prices: %v", got)
+	}
+}
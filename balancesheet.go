@@ -0,0 +1,205 @@
+// https://tushare.pro/document/2?doc_id=36
+
+package tushare
+
+import (
+	"context"
+	"slices"
+)
+
+// BalanceSheet 资产负债表，金额单位为元
+type BalanceSheet struct {
+	Columns `json:"-"`
+	Report
+	TotalShare            float64 `tushare:"total_share"`                // 期末总股本
+	CapRese               float64 `tushare:"cap_rese"`                   // 资本公积金
+	UndistrPorfit         float64 `tushare:"undistr_porfit"`             // 未分配利润
+	SurplusRese           float64 `tushare:"surplus_rese"`               // 盈余公积金
+	SpecialRese           float64 `tushare:"special_rese"`               // 专项储备
+	MoneyCap              float64 `tushare:"money_cap"`                  // 货币资金
+	TradAsset             float64 `tushare:"trad_asset"`                 // 交易性金融资产
+	NotesReceiv           float64 `tushare:"notes_receiv"`               // 应收票据
+	AccountsReceiv        float64 `tushare:"accounts_receiv"`            // 应收账款
+	OthReceiv             float64 `tushare:"oth_receiv"`                 // 其他应收款
+	Prepayment            float64 `tushare:"prepayment"`                 // 预付款项
+	DivReceiv             float64 `tushare:"div_receiv"`                 // 应收股利
+	IntReceiv             float64 `tushare:"int_receiv"`                 // 应收利息
+	Inventories           float64 `tushare:"inventories"`                // 存货
+	AmorExp               float64 `tushare:"amor_exp"`                   // 待摊费用
+	NcaWithin1y           float64 `tushare:"nca_within_1y"`              // 一年内到期的非流动资产
+	SettRsrv              float64 `tushare:"sett_rsrv"`                  // 结算备付金
+	LoantoOthBankFi       float64 `tushare:"loanto_oth_bank_fi"`         // 拆出资金
+	PremiumReceiv         float64 `tushare:"premium_receiv"`             // 应收保费
+	ReinsurReceiv         float64 `tushare:"reinsur_receiv"`             // 应收分保账款
+	ReinsurResReceiv      float64 `tushare:"reinsur_res_receiv"`         // 应收分保合同准备金
+	PurResaleFa           float64 `tushare:"pur_resale_fa"`              // 买入返售金融资产
+	OthCurAssets          float64 `tushare:"oth_cur_assets"`             // 其他流动资产
+	TotalCurAssets        float64 `tushare:"total_cur_assets"`           // 流动资产合计
+	FaAvailForSale        float64 `tushare:"fa_avail_for_sale"`          // 可供出售金融资产
+	HtmInvest             float64 `tushare:"htm_invest"`                 // 持有至到期投资
+	LtEqtInvest           float64 `tushare:"lt_eqt_invest"`              // 长期股权投资
+	InvestRealEstate      float64 `tushare:"invest_real_estate"`         // 投资性房地产
+	TimeDeposits          float64 `tushare:"time_deposits"`              // 定期存款
+	OthAssets             float64 `tushare:"oth_assets"`                 // 其他资产
+	LtRec                 float64 `tushare:"lt_rec"`                     // 长期应收款
+	FixAssets             float64 `tushare:"fix_assets"`                 // 固定资产
+	Cip                   float64 `tushare:"cip"`                        // 在建工程
+	ConstMaterials        float64 `tushare:"const_materials"`            // 工程物资
+	FixedAssetsDisp       float64 `tushare:"fixed_assets_disp"`          // 固定资产清理
+	ProducBioAssets       float64 `tushare:"produc_bio_assets"`          // 生产性生物资产
+	OilAndGasAssets       float64 `tushare:"oil_and_gas_assets"`         // 油气资产
+	IntanAssets           float64 `tushare:"intan_assets"`               // 无形资产
+	RAndD                 float64 `tushare:"r_and_d"`                    // 研发支出
+	Goodwill              float64 `tushare:"goodwill"`                   // 商誉
+	LtAmorExp             float64 `tushare:"lt_amor_exp"`                // 长期待摊费用
+	DeferTaxAssets        float64 `tushare:"defer_tax_assets"`           // 递延所得税资产
+	DecrInDisbur          float64 `tushare:"decr_in_disbur"`             // 发放贷款及垫款
+	OthNca                float64 `tushare:"oth_nca"`                    // 其他非流动资产
+	TotalNca              float64 `tushare:"total_nca"`                  // 非流动资产合计
+	CashReserCb           float64 `tushare:"cash_reser_cb"`              // 现金及存放中央银行款项
+	DeposInOthBfi         float64 `tushare:"depos_in_oth_bfi"`           // 存放同业和其它金融机构款项
+	PrecMetals            float64 `tushare:"prec_metals"`                // 贵金属
+	DerivAssets           float64 `tushare:"deriv_assets"`               // 衍生金融资产
+	RefundDepos           float64 `tushare:"refund_depos"`               // 存出保证金
+	TotalAssets           float64 `tushare:"total_assets"`               // 资产总计
+	LtBorr                float64 `tushare:"lt_borr"`                    // 长期借款
+	StBorr                float64 `tushare:"st_borr"`                    // 短期借款
+	CbBorr                float64 `tushare:"cb_borr"`                    // 向中央银行借款
+	DeposIbDeposits       float64 `tushare:"depos_ib_deposits"`          // 吸收存款及同业存放
+	LoanOthBank           float64 `tushare:"loan_oth_bank"`              // 拆入资金
+	TradingFl             float64 `tushare:"trading_fl"`                 // 交易性金融负债
+	NotesPayable          float64 `tushare:"notes_payable"`              // 应付票据
+	AcctPayable           float64 `tushare:"acct_payable"`               // 应付账款
+	AdvReceipts           float64 `tushare:"adv_receipts"`               // 预收款项
+	SoldForRepurFa        float64 `tushare:"sold_for_repur_fa"`          // 卖出回购金融资产款
+	CommPayable           float64 `tushare:"comm_payable"`               // 应付手续费及佣金
+	PayrollPayable        float64 `tushare:"payroll_payable"`            // 应付职工薪酬
+	TaxesPayable          float64 `tushare:"taxes_payable"`              // 应交税费
+	IntPayable            float64 `tushare:"int_payable"`                // 应付利息
+	DivPayable            float64 `tushare:"div_payable"`                // 应付股利
+	OthPayable            float64 `tushare:"oth_payable"`                // 其他应付款
+	AccExp                float64 `tushare:"acc_exp"`                    // 预提费用
+	DeferredInc           float64 `tushare:"deferred_inc"`               // 递延收益
+	StBondsPayable        float64 `tushare:"st_bonds_payable"`           // 应付短期债券
+	PayableToReinsurer    float64 `tushare:"payable_to_reinsurer"`       // 应付分保账款
+	RsrvInsurCont         float64 `tushare:"rsrv_insur_cont"`            // 保险合同准备金
+	ActingTradingSec      float64 `tushare:"acting_trading_sec"`         // 代理买卖证券款
+	ActingUwSec           float64 `tushare:"acting_uw_sec"`              // 代理承销证券款
+	NonCurLiabDue1y       float64 `tushare:"non_cur_liab_due_1y"`        // 一年内到期的非流动负债
+	OthCurLiab            float64 `tushare:"oth_cur_liab"`               // 其他流动负债
+	TotalCurLiab          float64 `tushare:"total_cur_liab"`             // 流动负债合计
+	BondPayable           float64 `tushare:"bond_payable"`               // 应付债券
+	LtPayable             float64 `tushare:"lt_payable"`                 // 长期应付款
+	SpecificPayables      float64 `tushare:"specific_payables"`          // 专项应付款
+	EstimatedLiab         float64 `tushare:"estimated_liab"`             // 预计负债
+	DeferTaxLiab          float64 `tushare:"defer_tax_liab"`             // 递延所得税负债
+	DeferIncNonCurLiab    float64 `tushare:"defer_inc_non_cur_liab"`     // 递延收益-非流动负债
+	OthNcl                float64 `tushare:"oth_ncl"`                    // 其他非流动负债
+	TotalNcl              float64 `tushare:"total_ncl"`                  // 非流动负债合计
+	DerivLiab             float64 `tushare:"deriv_liab"`                 // 衍生金融负债
+	Depos                 float64 `tushare:"depos"`                      // 吸收存款
+	AgencyBusLiab         float64 `tushare:"agency_bus_liab"`            // 代理业务负债
+	OthLiab               float64 `tushare:"oth_liab"`                   // 其他负债
+	PremReceivAdva        float64 `tushare:"prem_receiv_adva"`           // 预收保费
+	DeposReceived         float64 `tushare:"depos_received"`             // 存入保证金
+	PhInvest              float64 `tushare:"ph_invest"`                  // 保户储金及投资款
+	ReserUnePrem          float64 `tushare:"reser_une_prem"`             // 未到期责任准备金
+	ReserOutstdClaims     float64 `tushare:"reser_outstd_claims"`        // 未决赔款准备金
+	ReserLinsLiab         float64 `tushare:"reser_lins_liab"`            // 寿险责任准备金
+	ReserLthinsLiab       float64 `tushare:"reser_lthins_liab"`          // 长期健康险责任准备金
+	IndeptAccLiab         float64 `tushare:"indept_acc_liab"`            // 独立账户负债
+	PledgeBorr            float64 `tushare:"pledge_borr"`                // 其中:质押借款
+	IndemPayable          float64 `tushare:"indem_payable"`              // 应付赔付款
+	PolicyDivPayable      float64 `tushare:"policy_div_payable"`         // 应付保单红利
+	TotalLiab             float64 `tushare:"total_liab"`                 // 负债合计
+	TreasuryShare         float64 `tushare:"treasury_share"`             // 减:库存股
+	OrdinRiskReser        float64 `tushare:"ordin_risk_reser"`           // 一般风险准备
+	ForexDiffer           float64 `tushare:"forex_differ"`               // 外币报表折算差额
+	InvestLossUnconf      float64 `tushare:"invest_loss_unconf"`         // 未确认的投资损失
+	MinorityInt           float64 `tushare:"minority_int"`               // 少数股东权益
+	TotalHldrEqyExcMinInt float64 `tushare:"total_hldr_eqy_exc_min_int"` // 股东权益合计(不含少数股东权益)
+	TotalHldrEqyIncMinInt float64 `tushare:"total_hldr_eqy_inc_min_int"` // 股东权益合计(含少数股东权益)
+	TotalLiabHldrEqy      float64 `tushare:"total_liab_hldr_eqy"`        // 负债及股东权益总计
+	LtPayrollPayable      float64 `tushare:"lt_payroll_payable"`         // 长期应付职工薪酬
+	OthCompIncome         float64 `tushare:"oth_comp_income"`            // 其他综合收益
+	OthEqtTools           float64 `tushare:"oth_eqt_tools"`              // 其他权益工具
+	OthEqtToolsPShr       float64 `tushare:"oth_eqt_tools_p_shr"`        // 其他权益工具(优先股)
+	LendingFunds          float64 `tushare:"lending_funds"`              // 融出资金
+	AccReceivable         float64 `tushare:"acc_receivable"`             // 应收款项
+	StFinPayable          float64 `tushare:"st_fin_payable"`             // 应付短期融资款
+	Payables              float64 `tushare:"payables"`                   // 应付款项
+	HfsAssets             float64 `tushare:"hfs_assets"`                 // 持有待售的资产
+	HfsSales              float64 `tushare:"hfs_sales"`                  // 持有待售的负债
+	CostFinAssets         float64 `tushare:"cost_fin_assets"`            // 以摊余成本计量的金融资产
+	FairValueFinAssets    float64 `tushare:"fair_value_fin_assets"`      // 以公允价值计量且其变动计入其他综合收益的金融资产
+	CipTotal              float64 `tushare:"cip_total"`                  // 在建工程(合计)
+	OthPayTotal           float64 `tushare:"oth_pay_total"`              // 其他应付款(合计)
+	LongPayTotal          float64 `tushare:"long_pay_total"`             // 长期应付款(合计)
+	DebtInvest            float64 `tushare:"debt_invest"`                // 债权投资
+	OthDebtInvest         float64 `tushare:"oth_debt_invest"`            // 其他债权投资
+	OthEqInvest           float64 `tushare:"oth_eq_invest"`              // 其他权益工具投资
+	OthIlliqFinAssets     float64 `tushare:"oth_illiq_fin_assets"`       // 其他非流动金融资产
+	OthEqPpbond           float64 `tushare:"oth_eq_ppbond"`              // 其他权益工具:永续债
+	ReceivFinancing       float64 `tushare:"receiv_financing"`           // 应收款项融资
+	UseRightAssets        float64 `tushare:"use_right_assets"`           // 使用权资产
+	LeaseLiab             float64 `tushare:"lease_liab"`                 // 租赁负债
+	ContractAssets        float64 `tushare:"contract_assets"`            // 合同资产
+	ContractLiab          float64 `tushare:"contract_liab"`              // 合同负债
+	AccountsReceivBill    float64 `tushare:"accounts_receiv_bill"`       // 应收票据及应收账款
+	AccountsPay           float64 `tushare:"accounts_pay"`               // 应付票据及应付账款
+	OthRcvTotal           float64 `tushare:"oth_rcv_total"`              // 其他应收款(合计)
+	FixAssetsTotal        float64 `tushare:"fix_assets_total"`           // 固定资产(合计)
+}
+
+var balanceSheetFields = slices.Concat(reportFields, []string{
+	"total_share", "cap_rese", "undistr_porfit", "surplus_rese", "special_rese",
+	"money_cap", "trad_asset", "notes_receiv", "accounts_receiv", "oth_receiv",
+	"prepayment", "div_receiv", "int_receiv", "inventories", "amor_exp", "nca_within_1y",
+	"sett_rsrv", "loanto_oth_bank_fi", "premium_receiv", "reinsur_receiv",
+	"reinsur_res_receiv", "pur_resale_fa", "oth_cur_assets", "total_cur_assets",
+	"fa_avail_for_sale", "htm_invest", "lt_eqt_invest", "invest_real_estate",
+	"time_deposits", "oth_assets", "lt_rec", "fix_assets", "cip", "const_materials",
+	"fixed_assets_disp", "produc_bio_assets", "oil_and_gas_assets", "intan_assets",
+	"r_and_d", "goodwill", "lt_amor_exp", "defer_tax_assets", "decr_in_disbur", "oth_nca",
+	"total_nca", "cash_reser_cb", "depos_in_oth_bfi", "prec_metals", "deriv_assets",
+	"refund_depos", "total_assets", "lt_borr", "st_borr", "cb_borr", "depos_ib_deposits",
+	"loan_oth_bank", "trading_fl", "notes_payable", "acct_payable", "adv_receipts",
+	"sold_for_repur_fa", "comm_payable", "payroll_payable", "taxes_payable", "int_payable",
+	"div_payable", "oth_payable", "acc_exp", "deferred_inc", "st_bonds_payable",
+	"payable_to_reinsurer", "rsrv_insur_cont", "acting_trading_sec", "acting_uw_sec",
+	"non_cur_liab_due_1y", "oth_cur_liab", "total_cur_liab", "bond_payable", "lt_payable",
+	"specific_payables", "estimated_liab", "defer_tax_liab", "defer_inc_non_cur_liab",
+	"oth_ncl", "total_ncl", "deriv_liab", "depos", "agency_bus_liab", "oth_liab",
+	"prem_receiv_adva", "depos_received", "ph_invest", "reser_une_prem",
+	"reser_outstd_claims", "reser_lins_liab", "reser_lthins_liab", "indept_acc_liab",
+	"pledge_borr", "indem_payable", "policy_div_payable", "total_liab", "treasury_share",
+	"ordin_risk_reser", "forex_differ", "invest_loss_unconf", "minority_int",
+	"total_hldr_eqy_exc_min_int", "total_hldr_eqy_inc_min_int", "total_liab_hldr_eqy",
+	"lt_payroll_payable", "oth_comp_income", "oth_eqt_tools", "oth_eqt_tools_p_shr",
+	"lending_funds", "acc_receivable", "st_fin_payable", "payables", "hfs_assets",
+	"hfs_sales", "cost_fin_assets", "fair_value_fin_assets", "cip_total", "oth_pay_total",
+	"long_pay_total", "debt_invest", "oth_debt_invest", "oth_eq_invest",
+	"oth_illiq_fin_assets", "oth_eq_ppbond", "receiv_financing", "use_right_assets",
+	"lease_liab", "contract_assets", "contract_liab", "accounts_receiv_bill",
+	"accounts_pay", "oth_rcv_total", "fix_assets_total",
+})
+
+// BalanceSheet 获取资产负债表，已被更正的数据会被去除
+func (cli *Client) BalanceSheet(opts ...reportOpt) ([]BalanceSheet, error) {
+	return cli.BalanceSheetContext(context.Background(), opts...)
+}
+
+// BalanceSheetContext 获取资产负债表，已被更正的数据会被去除
+func (cli *Client) BalanceSheetContext(ctx context.Context, opts ...reportOpt) ([]BalanceSheet, error) {
+	return report[BalanceSheet](ctx, cli, "balancesheet", balanceSheetFields, opts...)
+}
+
+// BalanceSheetVip 获取VIP资产负债表，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) BalanceSheetVip(opts ...reportOpt) ([]BalanceSheet, error) {
+	return cli.BalanceSheetVipContext(context.Background(), opts...)
+}
+
+// BalanceSheetVipContext 获取VIP资产负债表，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) BalanceSheetVipContext(ctx context.Context, opts ...reportOpt) ([]BalanceSheet, error) {
+	return report[BalanceSheet](ctx, cli, "balancesheet_vip", balanceSheetFields, opts...)
+}
@@ -2,60 +2,49 @@
 
 package tushare
 
-// StockBasic 股票基本信息
+import (
+	"context"
+	"time"
+)
+
+// StockBasic 股票基本信息，默认仅获取代码、名称、地域及行业，
+// 其他列可通过WithExtraFields获取
 type StockBasic struct {
-	Code     string // 股票代码
-	Symbol   string // 股票代码(无后缀)
-	Name     string // 股票名称
-	Area     string // 地域
-	Industry string // 行业
+	Columns    `json:"-"`
+	Code       string        `tushare:"ts_code"`          // 股票代码
+	Symbol     string        `tushare:"symbol"`           // 股票代码(无后缀)
+	Name       string        `tushare:"name"`             // 股票名称
+	Area       string        `tushare:"area"`             // 地域
+	Industry   string        `tushare:"industry"`         // 行业
+	FullName   string        `tushare:"fullname"`         // 股票全称
+	EnName     string        `tushare:"enname"`           // 英文全称
+	CnSpell    string        `tushare:"cnspell"`          // 拼音缩写
+	Market     basicMarket   `tushare:"market"`           // 市场类型(主板/创业板/科创板/北交所)
+	Exchange   basicExchange `tushare:"exchange"`         // 交易所代码
+	CurrType   string        `tushare:"curr_type"`        // 交易货币
+	Status     basicStatus   `tushare:"list_status"`      // 上市状态
+	ListDate   time.Time     `tushare:"list_date,date"`   // 上市日期
+	DelistDate time.Time     `tushare:"delist_date,date"` // 退市日期
+	IsHS       string        `tushare:"is_hs"`            // 是否沪深港通标的(N否/H沪股通/S深股通)
+	ActName    string        `tushare:"act_name"`         // 实控人名称
+	ActEntType string        `tushare:"act_ent_type"`     // 实控人企业性质
 }
 
 type basicOpt func(Args)
 
 // StockBasic 获取股票列表
 func (cli *Client) StockBasic(opts ...basicOpt) ([]StockBasic, error) {
+	return cli.StockBasicContext(context.Background(), opts...)
+}
+
+// StockBasicContext 获取股票列表
+func (cli *Client) StockBasicContext(ctx context.Context, opts ...basicOpt) ([]StockBasic, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call("stock_basic", args,
+	return query[StockBasic](ctx, cli, "stock_basic", args,
 		[]string{"ts_code", "symbol", "name", "area", "industry"})
-	if err != nil {
-		return nil, err
-	}
-	var idxCode, idxSymbol, idxName, idxArea, idxIndustry int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "symbol":
-			idxSymbol = i
-		case "name":
-			idxName = i
-		case "area":
-			idxArea = i
-		case "industry":
-			idxIndustry = i
-		}
-	}
-	items := make([]StockBasic, len(data))
-	toString := func(v any) string {
-		if v == nil {
-			return ""
-		}
-		return v.(string)
-	}
-	for i, item := range data {
-		items[i] = StockBasic{
-			Code:     toString(item[idxCode]),
-			Symbol:   toString(item[idxSymbol]),
-			Name:     toString(item[idxName]),
-			Area:     toString(item[idxArea]),
-			Industry: toString(item[idxIndustry]),
-		}
-	}
-	return items, nil
 }
 
 // WithBasicCode 按股票代码查询
@@ -0,0 +1,240 @@
+// https://tushare.pro/document/2?doc_id=26
+// https://tushare.pro/document/2?doc_id=250
+
+package tushare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+)
+
+type calendarExchange string
+
+const (
+	CalendarSSE   calendarExchange = "SSE"   // 上交所
+	CalendarSZSE  calendarExchange = "SZSE"  // 深交所
+	CalendarBSE   calendarExchange = "BSE"   // 北交所
+	CalendarCFFEX calendarExchange = "CFFEX" // 中金所
+	CalendarSHFE  calendarExchange = "SHFE"  // 上期所
+	CalendarCZCE  calendarExchange = "CZCE"  // 郑商所
+	CalendarDCE   calendarExchange = "DCE"   // 大商所
+	CalendarINE   calendarExchange = "INE"   // 上能源
+	CalendarHKEX  calendarExchange = "HKEX"  // 港交所
+)
+
+// Calendar 交易所的交易日历，通过Client.Calendar加载后可保存到文件供离线使用，
+// 所有查询仅在Start至End的范围内有效，超出范围时返回false
+type Calendar struct {
+	Exchange calendarExchange
+	Start    Date           // 日历的开始日期
+	End      Date           // 日历的结束日期
+	Location *time.Location // 返回的时间所在的时区，为空时使用Shanghai
+	days     []Date         // 升序排列的交易日
+}
+
+// Calendar 加载指定交易所在日期范围内的交易日历
+func (cli *Client) Calendar(exchange calendarExchange, start, end time.Time) (*Calendar, error) {
+	return cli.CalendarContext(context.Background(), exchange, start, end)
+}
+
+// CalendarContext 加载指定交易所在日期范围内的交易日历
+func (cli *Client) CalendarContext(ctx context.Context, exchange calendarExchange, start, end time.Time) (*Calendar, error) {
+	api := "trade_cal"
+	args := Args{
+		"start_date": start,
+		"end_date":   end,
+	}
+	if exchange == CalendarHKEX {
+		api = "hk_tradecal"
+	} else {
+		args["exchange"] = exchange
+	}
+	rows, err := query[struct {
+		Date    Date `tushare:"cal_date"`
+		IsOpen  int  `tushare:"is_open"`
+		PreDate Date `tushare:"pretrade_date"`
+	}](ctx, cli, api, args, []string{"cal_date", "is_open", "pretrade_date"})
+	if err != nil {
+		return nil, err
+	}
+	cal := &Calendar{
+		Exchange: exchange,
+		Start:    DateOf(start.In(cli.loc)),
+		End:      DateOf(end.In(cli.loc)),
+		Location: cli.loc,
+	}
+	for _, row := range rows {
+		if row.IsOpen == 1 {
+			cal.days = append(cal.days, row.Date)
+		}
+		// 开始日期之前的最后一个交易日，用于在开始日期查询Prev
+		if !row.PreDate.IsZero() && row.PreDate.Before(cal.Start) {
+			cal.days = append(cal.days, row.PreDate)
+		}
+	}
+	slices.SortFunc(cal.days, Date.Compare)
+	cal.days = slices.Compact(cal.days)
+	if len(cal.days) > 0 && cal.days[0].Before(cal.Start) {
+		cal.Start = cal.days[0]
+	}
+	return cal, nil
+}
+
+func (c *Calendar) loc() *time.Location {
+	if c.Location == nil {
+		return Shanghai
+	}
+	return c.Location
+}
+
+func (c *Calendar) covers(d Date) bool {
+	return !d.Before(c.Start) && !d.After(c.End)
+}
+
+// search 返回第一个不早于d的交易日的下标，以及d是否为交易日
+func (c *Calendar) search(t time.Time) (Date, int, bool) {
+	d := DateOf(t.In(c.loc()))
+	i, found := slices.BinarySearchFunc(c.days, d, Date.Compare)
+	return d, i, found
+}
+
+// Days 返回日期范围内的所有交易日
+func (c *Calendar) Days(start, end time.Time) []time.Time {
+	_, i, _ := c.search(start)
+	var ret []time.Time
+	for _, d := range c.days[i:] {
+		if d.After(DateOf(end.In(c.loc()))) {
+			break
+		}
+		ret = append(ret, d.In(c.loc()))
+	}
+	return ret
+}
+
+// IsTradingDay 判断t是否为交易日，t不在日历范围内时ok为false
+func (c *Calendar) IsTradingDay(t time.Time) (trading, ok bool) {
+	d, _, found := c.search(t)
+	if !c.covers(d) {
+		return false, false
+	}
+	return found, true
+}
+
+// Next 返回t之后的下一个交易日
+func (c *Calendar) Next(t time.Time) (time.Time, bool) {
+	return c.AddTradingDays(t, 1)
+}
+
+// Prev 返回t之前的上一个交易日
+func (c *Calendar) Prev(t time.Time) (time.Time, bool) {
+	return c.AddTradingDays(t, -1)
+}
+
+// AddTradingDays 返回t之后第n个交易日，n为负数时返回之前第-n个交易日，
+// t不是交易日时n为0返回下一个交易日
+func (c *Calendar) AddTradingDays(t time.Time, n int) (time.Time, bool) {
+	d, i, found := c.search(t)
+	if !c.covers(d) {
+		return time.Time{}, false
+	}
+	if !found && n > 0 {
+		// 下一个交易日即为第1个交易日
+		i--
+	}
+	i += n
+	if i < 0 || i >= len(c.days) || !c.covers(c.days[i]) {
+		return time.Time{}, false
+	}
+	return c.days[i].In(c.loc()), true
+}
+
+// TradingDaysBetween 返回start至end之间(包含两端)的交易日数量，
+// start或end不在日历范围内时ok为false
+func (c *Calendar) TradingDaysBetween(start, end time.Time) (int, bool) {
+	ds, i, _ := c.search(start)
+	de, j, found := c.search(end)
+	if !c.covers(ds) || !c.covers(de) {
+		return 0, false
+	}
+	if found {
+		j++
+	}
+	return max(j-i, 0), true
+}
+
+type calendarFile struct {
+	Exchange calendarExchange `json:"exchange"`
+	Start    Date             `json:"start"`
+	End      Date             `json:"end"`
+	Location string           `json:"location,omitempty"`
+	Days     []Date           `json:"days"`
+}
+
+// Save 将交易日历以JSON格式写入w，包含时区名称
+func (c *Calendar) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(calendarFile{
+		Exchange: c.Exchange,
+		Start:    c.Start,
+		End:      c.End,
+		Location: c.loc().String(),
+		Days:     c.days,
+	})
+}
+
+// SaveFile 将交易日历保存到文件
+func (c *Calendar) SaveFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := c.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadCalendar 从r中读取Save保存的交易日历，时区为保存时的时区，
+// 未保存时区时使用Shanghai，可通过修改Location字段使用其他时区
+func LoadCalendar(r io.Reader) (*Calendar, error) {
+	var file calendarFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("load calendar: %w", err)
+	}
+	loc, err := loadLocation(file.Location)
+	if err != nil {
+		return nil, fmt.Errorf("load calendar: %w", err)
+	}
+	days := slices.Clone(file.Days)
+	slices.SortFunc(days, Date.Compare)
+	return &Calendar{
+		Exchange: file.Exchange,
+		Start:    file.Start,
+		End:      file.End,
+		Location: loc,
+		days:     slices.Compact(days),
+	}, nil
+}
+
+func loadLocation(name string) (*time.Location, error) {
+	switch name {
+	case "", Shanghai.String():
+		return Shanghai, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// LoadCalendarFile 从文件中读取SaveFile保存的交易日历
+func LoadCalendarFile(name string) (*Calendar, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadCalendar(f)
+}
@@ -0,0 +1,113 @@
+package tushare
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCalendar 2024-01-02至2024-01-12的工作日日历，不含1月10日
+func testCalendar() *Calendar {
+	cal := &Calendar{
+		Exchange: CalendarSSE,
+		Start:    DateOf(testDay(2024, 1, 2)),
+		End:      DateOf(testDay(2024, 1, 12)),
+	}
+	for _, d := range []int{2, 3, 4, 5, 8, 9, 11, 12} {
+		cal.days = append(cal.days, DateOf(testDay(2024, 1, d)))
+	}
+	return cal
+}
+
+func TestCalendarIsTradingDay(t *testing.T) {
+	cal := testCalendar()
+	tests := []struct {
+		day         time.Time
+		trading, ok bool
+	}{
+		{testDay(2024, 1, 2), true, true},
+		{testDay(2024, 1, 6), false, true},
+		{testDay(2024, 1, 10), false, true},
+		{testDay(2024, 1, 1), false, false},
+		{testDay(2024, 1, 15), false, false},
+	}
+	for _, tt := range tests {
+		trading, ok := cal.IsTradingDay(tt.day)
+		if trading != tt.trading || ok != tt.ok {
+			t.Errorf("IsTradingDay(%v) = %v, %v, want %v, %v", tt.day, trading, ok, tt.trading, tt.ok)
+		}
+	}
+}
+
+func TestCalendarTradingDaysBetween(t *testing.T) {
+	cal := testCalendar()
+	tests := []struct {
+		start, end time.Time
+		n          int
+		ok         bool
+	}{
+		{testDay(2024, 1, 2), testDay(2024, 1, 12), 8, true},
+		{testDay(2024, 1, 6), testDay(2024, 1, 10), 2, true},
+		{testDay(2024, 1, 12), testDay(2024, 1, 2), 0, true},
+		{testDay(2023, 12, 29), testDay(2024, 1, 12), 0, false},
+		{testDay(2024, 1, 2), testDay(2024, 1, 15), 0, false},
+	}
+	for _, tt := range tests {
+		n, ok := cal.TradingDaysBetween(tt.start, tt.end)
+		if n != tt.n || ok != tt.ok {
+			t.Errorf("TradingDaysBetween(%v, %v) = %d, %v, want %d, %v", tt.start, tt.end, n, ok, tt.n, tt.ok)
+		}
+	}
+}
+
+func TestCalendarAddTradingDays(t *testing.T) {
+	cal := testCalendar()
+	if d, ok := cal.Next(testDay(2024, 1, 9)); !ok || !d.Equal(testDay(2024, 1, 11)) {
+		t.Errorf("Next = %v, %v", d, ok)
+	}
+	if d, ok := cal.Prev(testDay(2024, 1, 10)); !ok || !d.Equal(testDay(2024, 1, 9)) {
+		t.Errorf("Prev = %v, %v", d, ok)
+	}
+	if _, ok := cal.Next(testDay(2024, 1, 12)); ok {
+		t.Error("Next after the last day should not be ok")
+	}
+}
+
+func TestCalendarSaveLoad(t *testing.T) {
+	for _, loc := range []*time.Location{nil, Shanghai, time.UTC} {
+		cal := testCalendar()
+		cal.Location = loc
+		var buf bytes.Buffer
+		if err := cal.Save(&buf); err != nil {
+			t.Fatal(err)
+		}
+		got, err := LoadCalendar(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.loc().String() != cal.loc().String() {
+			t.Errorf("location = %v, want %v", got.loc(), cal.loc())
+		}
+		if got.Exchange != cal.Exchange || got.Start != cal.Start || got.End != cal.End || len(got.days) != len(cal.days) {
+			t.Errorf("loaded = %+v, want %+v", got, cal)
+		}
+		d, ok := got.Next(time.Date(2024, 1, 9, 0, 0, 0, 0, got.loc()))
+		if !ok || d.Location() != got.loc() || DateOf(d) != DateOf(testDay(2024, 1, 11)) {
+			t.Errorf("Next = %v, %v", d, ok)
+		}
+	}
+
+	// 旧版本保存的文件没有时区，使用Shanghai
+	got, err := LoadCalendar(strings.NewReader(`{"exchange":"SSE","start":"20240102","end":"20240103","days":["20240102"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Location != Shanghai {
+		t.Errorf("location = %v, want Shanghai", got.Location)
+	}
+
+	if _, err := LoadCalendar(strings.NewReader(`{"location":"Nowhere/Unknown"}`)); err == nil {
+		t.Error("expected an error for an unknown location")
+	}
+}
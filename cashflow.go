@@ -0,0 +1,150 @@
+// https://tushare.pro/document/2?doc_id=44
+
+package tushare
+
+import (
+	"context"
+	"slices"
+)
+
+// CashFlow 现金流量表，金额单位为元
+type CashFlow struct {
+	Columns `json:"-"`
+	Report
+	NetProfit               float64 `tushare:"net_profit"`                  // 净利润
+	FinanExp                float64 `tushare:"finan_exp"`                   // 财务费用
+	CFrSaleSg               float64 `tushare:"c_fr_sale_sg"`                // 销售商品、提供劳务收到的现金
+	RecpTaxRends            float64 `tushare:"recp_tax_rends"`              // 收到的税费返还
+	NDeposIncrFi            float64 `tushare:"n_depos_incr_fi"`             // 客户存款和同业存放款项净增加额
+	NIncrLoansCb            float64 `tushare:"n_incr_loans_cb"`             // 向中央银行借款净增加额
+	NIncBorrOthFi           float64 `tushare:"n_inc_borr_oth_fi"`           // 向其他金融机构拆入资金净增加额
+	PremFrOrigContr         float64 `tushare:"prem_fr_orig_contr"`          // 收到原保险合同保费取得的现金
+	NIncrInsuredDep         float64 `tushare:"n_incr_insured_dep"`          // 保户储金净增加额
+	NReinsurPrem            float64 `tushare:"n_reinsur_prem"`              // 收到再保业务现金净额
+	NIncrDispTfa            float64 `tushare:"n_incr_disp_tfa"`             // 处置交易性金融资产净增加额
+	IfcCashIncr             float64 `tushare:"ifc_cash_incr"`               // 收取利息和手续费净增加额
+	NIncrDispFaas           float64 `tushare:"n_incr_disp_faas"`            // 处置可供出售金融资产净增加额
+	NIncrLoansOthBank       float64 `tushare:"n_incr_loans_oth_bank"`       // 拆入资金净增加额
+	NCapIncrRepur           float64 `tushare:"n_cap_incr_repur"`            // 回购业务资金净增加额
+	CFrOthOperateA          float64 `tushare:"c_fr_oth_operate_a"`          // 收到其他与经营活动有关的现金
+	CInfFrOperateA          float64 `tushare:"c_inf_fr_operate_a"`          // 经营活动现金流入小计
+	CPaidGoodsS             float64 `tushare:"c_paid_goods_s"`              // 购买商品、接受劳务支付的现金
+	CPaidToForEmpl          float64 `tushare:"c_paid_to_for_empl"`          // 支付给职工以及为职工支付的现金
+	CPaidForTaxes           float64 `tushare:"c_paid_for_taxes"`            // 支付的各项税费
+	NIncrCltLoanAdv         float64 `tushare:"n_incr_clt_loan_adv"`         // 客户贷款及垫款净增加额
+	NIncrDepCbob            float64 `tushare:"n_incr_dep_cbob"`             // 存放央行和同业款项净增加额
+	CPayClaimsOrigInco      float64 `tushare:"c_pay_claims_orig_inco"`      // 支付原保险合同赔付款项的现金
+	PayHandlingChrg         float64 `tushare:"pay_handling_chrg"`           // 支付手续费的现金
+	PayCommInsurPlcy        float64 `tushare:"pay_comm_insur_plcy"`         // 支付保单红利的现金
+	OthCashPayOperAct       float64 `tushare:"oth_cash_pay_oper_act"`       // 支付其他与经营活动有关的现金
+	StCashOutAct            float64 `tushare:"st_cash_out_act"`             // 经营活动现金流出小计
+	NCashflowAct            float64 `tushare:"n_cashflow_act"`              // 经营活动产生的现金流量净额
+	OthRecpRalInvAct        float64 `tushare:"oth_recp_ral_inv_act"`        // 收到其他与投资活动有关的现金
+	CDispWithdrwlInvest     float64 `tushare:"c_disp_withdrwl_invest"`      // 收回投资收到的现金
+	CRecpReturnInvest       float64 `tushare:"c_recp_return_invest"`        // 取得投资收益收到的现金
+	NRecpDispFiolta         float64 `tushare:"n_recp_disp_fiolta"`          // 处置固定资产、无形资产和其他长期资产收回的现金净额
+	NRecpDispSobu           float64 `tushare:"n_recp_disp_sobu"`            // 处置子公司及其他营业单位收到的现金净额
+	StotInflowsInvAct       float64 `tushare:"stot_inflows_inv_act"`        // 投资活动现金流入小计
+	CPayAcqConstFiolta      float64 `tushare:"c_pay_acq_const_fiolta"`      // 购建固定资产、无形资产和其他长期资产支付的现金
+	CPaidInvest             float64 `tushare:"c_paid_invest"`               // 投资支付的现金
+	NDispSubsOthBiz         float64 `tushare:"n_disp_subs_oth_biz"`         // 取得子公司及其他营业单位支付的现金净额
+	OthPayRalInvAct         float64 `tushare:"oth_pay_ral_inv_act"`         // 支付其他与投资活动有关的现金
+	NIncrPledgeLoan         float64 `tushare:"n_incr_pledge_loan"`          // 质押贷款净增加额
+	StotOutInvAct           float64 `tushare:"stot_out_inv_act"`            // 投资活动现金流出小计
+	NCashflowInvAct         float64 `tushare:"n_cashflow_inv_act"`          // 投资活动产生的现金流量净额
+	CRecpBorrow             float64 `tushare:"c_recp_borrow"`               // 取得借款收到的现金
+	ProcIssueBonds          float64 `tushare:"proc_issue_bonds"`            // 发行债券收到的现金
+	OthCashRecpRalFncAct    float64 `tushare:"oth_cash_recp_ral_fnc_act"`   // 收到其他与筹资活动有关的现金
+	StotCashInFncAct        float64 `tushare:"stot_cash_in_fnc_act"`        // 筹资活动现金流入小计
+	FreeCashflow            float64 `tushare:"free_cashflow"`               // 企业自由现金流量
+	CPrepayAmtBorr          float64 `tushare:"c_prepay_amt_borr"`           // 偿还债务支付的现金
+	CPayDistDpcpIntExp      float64 `tushare:"c_pay_dist_dpcp_int_exp"`     // 分配股利、利润或偿付利息支付的现金
+	InclDvdProfitPaidScMs   float64 `tushare:"incl_dvd_profit_paid_sc_ms"`  // 其中:子公司支付给少数股东的股利、利润
+	OthCashpayRalFncAct     float64 `tushare:"oth_cashpay_ral_fnc_act"`     // 支付其他与筹资活动有关的现金
+	StotCashoutFncAct       float64 `tushare:"stot_cashout_fnc_act"`        // 筹资活动现金流出小计
+	NCashFlowsFncAct        float64 `tushare:"n_cash_flows_fnc_act"`        // 筹资活动产生的现金流量净额
+	EffFxFluCash            float64 `tushare:"eff_fx_flu_cash"`             // 汇率变动对现金的影响
+	NIncrCashCashEqu        float64 `tushare:"n_incr_cash_cash_equ"`        // 现金及现金等价物净增加额
+	CCashEquBegPeriod       float64 `tushare:"c_cash_equ_beg_period"`       // 期初现金及现金等价物余额
+	CCashEquEndPeriod       float64 `tushare:"c_cash_equ_end_period"`       // 期末现金及现金等价物余额
+	CRecpCapContrib         float64 `tushare:"c_recp_cap_contrib"`          // 吸收投资收到的现金
+	InclCashRecSaims        float64 `tushare:"incl_cash_rec_saims"`         // 其中:子公司吸收少数股东投资收到的现金
+	UnconInvestLoss         float64 `tushare:"uncon_invest_loss"`           // 未确认投资损失
+	ProvDeprAssets          float64 `tushare:"prov_depr_assets"`            // 加:资产减值准备
+	DeprFaCogaDpba          float64 `tushare:"depr_fa_coga_dpba"`           // 固定资产折旧、油气资产折耗、生产性生物资产折旧
+	AmortIntangAssets       float64 `tushare:"amort_intang_assets"`         // 无形资产摊销
+	LtAmortDeferredExp      float64 `tushare:"lt_amort_deferred_exp"`       // 长期待摊费用摊销
+	DecrDeferredExp         float64 `tushare:"decr_deferred_exp"`           // 待摊费用减少
+	IncrAccExp              float64 `tushare:"incr_acc_exp"`                // 预提费用增加
+	LossDispFiolta          float64 `tushare:"loss_disp_fiolta"`            // 处置固定、无形资产和其他长期资产的损失
+	LossScrFa               float64 `tushare:"loss_scr_fa"`                 // 固定资产报废损失
+	LossFvChg               float64 `tushare:"loss_fv_chg"`                 // 公允价值变动损失
+	InvestLoss              float64 `tushare:"invest_loss"`                 // 投资损失
+	DecrDefIncTaxAssets     float64 `tushare:"decr_def_inc_tax_assets"`     // 递延所得税资产减少
+	IncrDefIncTaxLiab       float64 `tushare:"incr_def_inc_tax_liab"`       // 递延所得税负债增加
+	DecrInventories         float64 `tushare:"decr_inventories"`            // 存货的减少
+	DecrOperPayable         float64 `tushare:"decr_oper_payable"`           // 经营性应收项目的减少
+	IncrOperPayable         float64 `tushare:"incr_oper_payable"`           // 经营性应付项目的增加
+	Others                  float64 `tushare:"others"`                      // 其他
+	ImNetCashflowOperAct    float64 `tushare:"im_net_cashflow_oper_act"`    // 经营活动产生的现金流量净额(间接法)
+	ConvDebtIntoCap         float64 `tushare:"conv_debt_into_cap"`          // 债务转为资本
+	ConvCopbondsDueWithin1y float64 `tushare:"conv_copbonds_due_within_1y"` // 一年内到期的可转换公司债券
+	FaFncLeases             float64 `tushare:"fa_fnc_leases"`               // 融资租入固定资产
+	ImNIncrCashEqu          float64 `tushare:"im_n_incr_cash_equ"`          // 现金及现金等价物净增加额(间接法)
+	NetDismCapitalAdd       float64 `tushare:"net_dism_capital_add"`        // 拆出资金净增加额
+	NetCashReceSec          float64 `tushare:"net_cash_rece_sec"`           // 代理买卖证券收到的现金净额(元)
+	CreditImpaLoss          float64 `tushare:"credit_impa_loss"`            // 信用减值损失
+	UseRightAssetDep        float64 `tushare:"use_right_asset_dep"`         // 使用权资产折旧
+	OthLossAsset            float64 `tushare:"oth_loss_asset"`              // 其他资产减值损失
+	EndBalCash              float64 `tushare:"end_bal_cash"`                // 现金的期末余额
+	BegBalCash              float64 `tushare:"beg_bal_cash"`                // 减:现金的期初余额
+	EndBalCashEqu           float64 `tushare:"end_bal_cash_equ"`            // 加:现金等价物的期末余额
+	BegBalCashEqu           float64 `tushare:"beg_bal_cash_equ"`            // 减:现金等价物的期初余额
+}
+
+var cashFlowFields = slices.Concat(reportFields, []string{
+	"net_profit", "finan_exp", "c_fr_sale_sg", "recp_tax_rends", "n_depos_incr_fi",
+	"n_incr_loans_cb", "n_inc_borr_oth_fi", "prem_fr_orig_contr", "n_incr_insured_dep",
+	"n_reinsur_prem", "n_incr_disp_tfa", "ifc_cash_incr", "n_incr_disp_faas",
+	"n_incr_loans_oth_bank", "n_cap_incr_repur", "c_fr_oth_operate_a", "c_inf_fr_operate_a",
+	"c_paid_goods_s", "c_paid_to_for_empl", "c_paid_for_taxes", "n_incr_clt_loan_adv",
+	"n_incr_dep_cbob", "c_pay_claims_orig_inco", "pay_handling_chrg", "pay_comm_insur_plcy",
+	"oth_cash_pay_oper_act", "st_cash_out_act", "n_cashflow_act", "oth_recp_ral_inv_act",
+	"c_disp_withdrwl_invest", "c_recp_return_invest", "n_recp_disp_fiolta",
+	"n_recp_disp_sobu", "stot_inflows_inv_act", "c_pay_acq_const_fiolta", "c_paid_invest",
+	"n_disp_subs_oth_biz", "oth_pay_ral_inv_act", "n_incr_pledge_loan", "stot_out_inv_act",
+	"n_cashflow_inv_act", "c_recp_borrow", "proc_issue_bonds", "oth_cash_recp_ral_fnc_act",
+	"stot_cash_in_fnc_act", "free_cashflow", "c_prepay_amt_borr", "c_pay_dist_dpcp_int_exp",
+	"incl_dvd_profit_paid_sc_ms", "oth_cashpay_ral_fnc_act", "stot_cashout_fnc_act",
+	"n_cash_flows_fnc_act", "eff_fx_flu_cash", "n_incr_cash_cash_equ",
+	"c_cash_equ_beg_period", "c_cash_equ_end_period", "c_recp_cap_contrib",
+	"incl_cash_rec_saims", "uncon_invest_loss", "prov_depr_assets", "depr_fa_coga_dpba",
+	"amort_intang_assets", "lt_amort_deferred_exp", "decr_deferred_exp", "incr_acc_exp",
+	"loss_disp_fiolta", "loss_scr_fa", "loss_fv_chg", "invest_loss",
+	"decr_def_inc_tax_assets", "incr_def_inc_tax_liab", "decr_inventories",
+	"decr_oper_payable", "incr_oper_payable", "others", "im_net_cashflow_oper_act",
+	"conv_debt_into_cap", "conv_copbonds_due_within_1y", "fa_fnc_leases",
+	"im_n_incr_cash_equ", "net_dism_capital_add", "net_cash_rece_sec", "credit_impa_loss",
+	"use_right_asset_dep", "oth_loss_asset", "end_bal_cash", "beg_bal_cash",
+	"end_bal_cash_equ", "beg_bal_cash_equ",
+})
+
+// CashFlow 获取现金流量表，已被更正的数据会被去除
+func (cli *Client) CashFlow(opts ...reportOpt) ([]CashFlow, error) {
+	return cli.CashFlowContext(context.Background(), opts...)
+}
+
+// CashFlowContext 获取现金流量表，已被更正的数据会被去除
+func (cli *Client) CashFlowContext(ctx context.Context, opts ...reportOpt) ([]CashFlow, error) {
+	return report[CashFlow](ctx, cli, "cashflow", cashFlowFields, opts...)
+}
+
+// CashFlowVip 获取VIP现金流量表，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) CashFlowVip(opts ...reportOpt) ([]CashFlow, error) {
+	return cli.CashFlowVipContext(context.Background(), opts...)
+}
+
+// CashFlowVipContext 获取VIP现金流量表，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) CashFlowVipContext(ctx context.Context, opts ...reportOpt) ([]CashFlow, error) {
+	return report[CashFlow](ctx, cli, "cashflow_vip", cashFlowFields, opts...)
+}
@@ -0,0 +1,133 @@
+package tushare
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRowLimit 大部分接口单次请求返回的最大行数
+const DefaultRowLimit = 5000
+
+type chunkConfig struct {
+	days        int
+	rowsPerDay  int
+	rowLimit    int
+	concurrency int
+}
+
+type chunkOpt func(*chunkConfig)
+
+// WithChunkDays 设置每个窗口包含的交易日数量，默认为1
+func WithChunkDays(days int) chunkOpt {
+	return func(cfg *chunkConfig) {
+		cfg.days = days
+	}
+}
+
+// WithChunkRows 根据每个交易日的预估行数及单次请求的行数上限计算窗口大小，
+// rowLimit<=0时使用DefaultRowLimit
+func WithChunkRows(rowsPerDay, rowLimit int) chunkOpt {
+	return func(cfg *chunkConfig) {
+		cfg.rowsPerDay = rowsPerDay
+		cfg.rowLimit = rowLimit
+	}
+}
+
+// WithChunkConcurrency 设置并发请求的窗口数量，默认为4
+func WithChunkConcurrency(n int) chunkOpt {
+	return func(cfg *chunkConfig) {
+		cfg.concurrency = n
+	}
+}
+
+func (cfg chunkConfig) windowDays() int {
+	if cfg.days > 0 {
+		return cfg.days
+	}
+	if cfg.rowsPerDay > 0 {
+		limit := cfg.rowLimit
+		if limit <= 0 {
+			limit = DefaultRowLimit
+		}
+		return max(limit/cfg.rowsPerDay, 1)
+	}
+	return 1
+}
+
+// ChunkRange 将[start, end]按交易日切分为多个窗口，并发调用fetch获取每个窗口的数据，
+// 按窗口顺序合并结果，key不为空时按key去重，例如:
+//
+//	ticks, err := tushare.ChunkRange(ctx, cli, start, end,
+//		func(ctx context.Context, start, end time.Time) ([]tushare.DailyTick, error) {
+//			return cli.DailyContext(ctx, tushare.WithDailyDateRange(start, end))
+//		}, func(t tushare.DailyTick) string {
+//			return t.Code + t.Time.Format("20060102")
+//		})
+func ChunkRange[T any](ctx context.Context, cli *Client, start, end time.Time,
+	fetch func(ctx context.Context, start, end time.Time) ([]T, error),
+	key func(T) string, opts ...chunkOpt) ([]T, error) {
+	cfg := chunkConfig{concurrency: 4}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	days, err := cli.TradeCalContext(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	type window struct {
+		start, end time.Time
+	}
+	var windows []window
+	size := cfg.windowDays()
+	for i := 0; i < len(days); i += size {
+		j := min(i+size, len(days)) - 1
+		windows = append(windows, window{days[i], days[j]})
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	results := make([][]T, len(windows))
+	sem := make(chan struct{}, max(cfg.concurrency, 1))
+	var wg sync.WaitGroup
+	for i, w := range windows {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			items, err := fetch(ctx, w.start, w.end)
+			if err != nil {
+				cancel(err)
+				return
+			}
+			results[i] = items
+		}()
+	}
+	wg.Wait()
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+
+	var ret []T
+	seen := make(map[string]struct{})
+	for _, items := range results {
+		for _, item := range items {
+			if key != nil {
+				k := key(item)
+				if _, ok := seen[k]; ok {
+					continue
+				}
+				seen[k] = struct{}{}
+			}
+			ret = append(ret, item)
+		}
+	}
+	return ret, nil
+}
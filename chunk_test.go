@@ -0,0 +1,161 @@
+package tushare_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+// chunkClient 2024-01-02至2024-01-12的交易日历，1月10日休市
+func chunkClient(t *testing.T) *tushare.Client {
+	t.Helper()
+	srv := tusharetest.NewServer()
+	t.Cleanup(srv.Close)
+	var open []time.Time
+	for _, d := range []int{2, 3, 4, 5, 8, 9, 11, 12} {
+		open = append(open, date(2024, 1, d))
+	}
+	srv.LoadTradeCal("SSE", open)
+	return tushare.New("", tushare.WithBaseURL(srv.URL))
+}
+
+// windowRecorder 记录ChunkRange切分的窗口，返回窗口的开始日期
+type windowRecorder struct {
+	mu      sync.Mutex
+	windows [][2]int
+}
+
+func (r *windowRecorder) fetch(ctx context.Context, start, end time.Time) ([]time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.windows = append(r.windows, [2]int{start.Day(), end.Day()})
+	return []time.Time{start}, nil
+}
+
+func (r *windowRecorder) sorted() [][2]int {
+	slices.SortFunc(r.windows, func(a, b [2]int) int { return a[0] - b[0] })
+	return r.windows
+}
+
+func TestChunkRangeWindows(t *testing.T) {
+	cli := chunkClient(t)
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		run  func(r *windowRecorder) ([]time.Time, error)
+		want [][2]int
+	}{
+		{"default", func(r *windowRecorder) ([]time.Time, error) {
+			return tushare.ChunkRange(ctx, cli, date(2024, 1, 2), date(2024, 1, 12), r.fetch, nil)
+		}, [][2]int{{2, 2}, {3, 3}, {4, 4}, {5, 5}, {8, 8}, {9, 9}, {11, 11}, {12, 12}}},
+		{"days", func(r *windowRecorder) ([]time.Time, error) {
+			return tushare.ChunkRange(ctx, cli, date(2024, 1, 2), date(2024, 1, 12), r.fetch, nil,
+				tushare.WithChunkDays(3))
+		}, [][2]int{{2, 4}, {5, 9}, {11, 12}}},
+		// 5000/2000=2个交易日
+		{"rows", func(r *windowRecorder) ([]time.Time, error) {
+			return tushare.ChunkRange(ctx, cli, date(2024, 1, 2), date(2024, 1, 12), r.fetch, nil,
+				tushare.WithChunkRows(2000, 0))
+		}, [][2]int{{2, 3}, {4, 5}, {8, 9}, {11, 12}}},
+		// 每天的行数超过上限时每个窗口为1个交易日
+		{"rows over limit", func(r *windowRecorder) ([]time.Time, error) {
+			return tushare.ChunkRange(ctx, cli, date(2024, 1, 8), date(2024, 1, 12), r.fetch, nil,
+				tushare.WithChunkRows(3000, 1000))
+		}, [][2]int{{8, 8}, {9, 9}, {11, 11}, {12, 12}}},
+		// 周末及休市日不会成为窗口的边界
+		{"non-trading days", func(r *windowRecorder) ([]time.Time, error) {
+			return tushare.ChunkRange(ctx, cli, date(2024, 1, 6), date(2024, 1, 10), r.fetch, nil,
+				tushare.WithChunkDays(5))
+		}, [][2]int{{8, 9}}},
+	}
+	for _, tt := range tests {
+		var r windowRecorder
+		if _, err := tt.run(&r); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := r.sorted(); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: windows = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestChunkRangeOrderAndDedupe(t *testing.T) {
+	cli := chunkClient(t)
+	// 越早的窗口返回得越晚，每个窗口还返回前一个交易日的数据
+	fetch := func(ctx context.Context, start, end time.Time) ([]time.Time, error) {
+		time.Sleep(time.Duration(13-start.Day()) * 5 * time.Millisecond)
+		days, err := cli.TradeCalContext(ctx, start.AddDate(0, 0, -3), end)
+		if err != nil {
+			return nil, err
+		}
+		return days[max(len(days)-2, 0):], nil
+	}
+	key := func(d time.Time) string {
+		return d.Format("20060102")
+	}
+	got, err := tushare.ChunkRange(context.Background(), cli, date(2024, 1, 2), date(2024, 1, 12), fetch, key,
+		tushare.WithChunkConcurrency(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var days []int
+	for _, d := range got {
+		days = append(days, d.Day())
+	}
+	if want := []int{2, 3, 4, 5, 8, 9, 11, 12}; !slices.Equal(days, want) {
+		t.Fatalf("days = %v, want %v", days, want)
+	}
+
+	// 不指定key时保留重复的数据
+	got, err = tushare.ChunkRange(context.Background(), cli, date(2024, 1, 3), date(2024, 1, 4), fetch, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("got %d rows, want 4: %v", len(got), got)
+	}
+}
+
+func TestChunkRangeErrorCancels(t *testing.T) {
+	cli := chunkClient(t)
+	errFetch := errors.New("fetch failed")
+	var calls, canceled atomic.Int32
+	fetch := func(ctx context.Context, start, end time.Time) ([]time.Time, error) {
+		calls.Add(1)
+		if start.Day() == 2 {
+			// 等待第二个窗口开始
+			time.Sleep(20 * time.Millisecond)
+			return nil, errFetch
+		}
+		select {
+		case <-ctx.Done():
+			canceled.Add(1)
+			return nil, ctx.Err()
+		case <-time.After(5 * time.Second):
+			return []time.Time{start}, nil
+		}
+	}
+	begin := time.Now()
+	_, err := tushare.ChunkRange(context.Background(), cli, date(2024, 1, 2), date(2024, 1, 12), fetch, nil,
+		tushare.WithChunkConcurrency(2))
+	if !errors.Is(err, errFetch) {
+		t.Fatalf("err = %v, want %v", err, errFetch)
+	}
+	if d := time.Since(begin); d > 2*time.Second {
+		t.Fatalf("returned after %v", d)
+	}
+	// 出错后不再开始新的窗口，已开始的窗口被取消
+	if n := calls.Load(); n >= 8 {
+		t.Fatalf("fetch called %d times after the error", n)
+	}
+	if canceled.Load() == 0 {
+		t.Fatal("running windows were not canceled")
+	}
+}
@@ -2,84 +2,176 @@ package tushare
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
+// DefaultBaseURL 默认接口地址
+const DefaultBaseURL = "http://api.tushare.pro"
+
+const defaultTimeout = 10 * time.Second
+
 type Client struct {
-	token string
-	cli   *http.Client
+	token     string
+	cli       *http.Client
+	baseURL   string
+	userAgent string
+	retry     RetryPolicy
+	limit     *limiter
+	apiLimits map[string]*limiter
+	limitMu   sync.Mutex
+	strict    bool
+	loc       *time.Location
+
+	// 以下参数在New中应用到http.Client上
+	timeout *time.Duration
+	proxy   *url.URL
+	err     error // 无法应用的配置，所有请求直接返回此错误
 }
 
 type Args map[string]any
 
-func New(token string) *Client {
-	return &Client{
-		token: token,
-		cli: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+type clientOpt func(*Client)
+
+func New(token string, opts ...clientOpt) *Client {
+	cli := &Client{
+		token:   token,
+		baseURL: DefaultBaseURL,
+		retry:   DefaultRetryPolicy,
+		loc:     Shanghai,
 	}
+	for _, o := range opts {
+		o(cli)
+	}
+	cli.cli, cli.err = cli.httpClient()
+	return cli
+}
+
+// page 单次请求返回的数据
+type page struct {
+	fields  []string
+	items   [][]any
+	hasMore bool // 是否还有更多数据未返回
 }
 
-func (cli *Client) call(api string, args Args, fields []string) ([]string, [][]any, error) {
+func (cli *Client) call(ctx context.Context, api string, args Args, fields []string) (page, error) {
 	if args == nil {
 		args = make(Args)
 	}
+	if err := cli.wait(ctx, api); err != nil {
+		return page{}, err
+	}
+	params := make(Args, len(args))
+	for k, v := range args {
+		params[k] = formatParam(v, cli.loc)
+	}
 	data, err := json.Marshal(map[string]any{
 		"api_name": api,
 		"token":    cli.token,
-		"params":   args,
+		"params":   params,
 		"fields":   strings.Join(fields, ","),
 	})
 	if err != nil {
-		return nil, nil, err
+		return page{}, err
 	}
-	const url = "http://api.tushare.pro"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cli.baseURL, bytes.NewReader(data))
 	if err != nil {
-		return nil, nil, err
+		return page{}, err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if cli.userAgent != "" {
+		req.Header.Set("User-Agent", cli.userAgent)
+	}
 	resp, err := cli.cli.Do(req)
 	if err != nil {
-		return nil, nil, err
+		return page{}, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil, nil, fmt.Errorf("http: %d", resp.StatusCode)
+		return page{}, &APIError{API: api, Params: args, Status: resp.StatusCode}
 	}
 	var ret struct {
 		Code int    `json:"code"`
 		Msg  string `json:"msg"`
 		Data struct {
-			Fields []string `json:"fields"`
-			Items  [][]any  `json:"items"`
+			Fields  []string `json:"fields"`
+			Items   [][]any  `json:"items"`
+			HasMore bool     `json:"has_more"`
 		} `json:"data"`
 	}
 	err = json.NewDecoder(resp.Body).Decode(&ret)
 	if err != nil {
-		return nil, nil, err
+		return page{}, err
 	}
 	if ret.Code != 0 {
-		return nil, nil, fmt.Errorf("code: %d, %s", ret.Code, ret.Msg)
+		return page{}, &APIError{
+			Code:   ret.Code,
+			Msg:    ret.Msg,
+			API:    api,
+			Params: args,
+			Status: resp.StatusCode,
+		}
 	}
-	return ret.Data.Fields, ret.Data.Items, nil
+	return page{
+		fields:  ret.Data.Fields,
+		items:   ret.Data.Items,
+		hasMore: ret.Data.HasMore,
+	}, nil
 }
 
+// Call 调用接口，失败时自动重试
 func (cli *Client) Call(api string, args Args, fields []string) ([]string, [][]any, error) {
-	var columns []string
-	var items [][]any
+	return cli.CallContext(context.Background(), api, args, fields)
+}
+
+// CallContext 调用接口，按重试策略自动重试，ctx取消时立即返回
+//
+// 失败时返回*RetryError，其中记录了实际的请求次数
+func (cli *Client) CallContext(ctx context.Context, api string, args Args, fields []string) ([]string, [][]any, error) {
+	p, err := cli.callRetry(ctx, api, args, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.fields, p.items, nil
+}
+
+func (cli *Client) callRetry(ctx context.Context, api string, args Args, fields []string) (page, error) {
+	if cli.err != nil {
+		return page{}, &RetryError{Err: cli.err}
+	}
+	attempts := max(cli.retry.MaxAttempts, 1)
 	var err error
-	for range 10 {
-		columns, items, err = cli.call(api, args, fields)
+	for i := range attempts {
+		var p page
+		p, err = cli.call(ctx, api, args, fields)
 		if err == nil {
-			return columns, items, nil
+			return p, nil
+		}
+		if ctx.Err() != nil {
+			return page{}, &RetryError{Attempts: i + 1, Err: ctx.Err()}
+		}
+		if i == attempts-1 || !cli.retry.retryable(err) {
+			return page{}, &RetryError{Attempts: i + 1, Err: err}
+		}
+		if err := sleep(ctx, cli.retry.delay(i)); err != nil {
+			return page{}, &RetryError{Attempts: i + 1, Err: err}
 		}
-		time.Sleep(time.Minute)
 	}
-	return nil, nil, err
+	return page{}, err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
 }
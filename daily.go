@@ -3,110 +3,80 @@
 package tushare
 
 import (
+	"context"
+	"iter"
 	"time"
 )
 
 // Tick 行情数据
 type Tick struct {
-	Code     string    // 股票代码
-	Time     time.Time // 时间
-	Open     float64   // 开盘价
-	High     float64   // 最高价
-	Low      float64   // 最低价
-	Close    float64   // 收盘价
-	Volume   float64   // 成交量(手)
-	Turnover float64   // 成交额(千元)
+	Columns  `json:"-"`
+	Code     string    `tushare:"ts_code"`         // 股票代码
+	Time     time.Time `tushare:"trade_date,date"` // 时间
+	Open     float64   `tushare:"open"`            // 开盘价
+	High     float64   `tushare:"high"`            // 最高价
+	Low      float64   `tushare:"low"`             // 最低价
+	Close    float64   `tushare:"close"`           // 收盘价
+	Volume   float64   `tushare:"vol"`             // 成交量(手)
+	Turnover float64   `tushare:"amount"`          // 成交额(千元)
 }
 
 // DailyTick 日线数据
 type DailyTick struct {
 	Tick
-	PreClose float64 // 昨收价
-	Change   float64 // 涨跌额
-	PctChg   float64 // 涨跌幅
+	PreClose float64 `tushare:"pre_close"` // 昨收价
+	Change   float64 `tushare:"change"`    // 涨跌额
+	PctChg   float64 `tushare:"pct_chg"`   // 涨跌幅
 }
 
 type dailyOpt func(Args)
 
-func (cli *Client) daily(api string, opts ...dailyOpt) ([]DailyTick, error) {
+var dailyFields = []string{
+	"ts_code", "trade_date",
+	"open", "high", "low", "close",
+	"pre_close", "change", "pct_chg",
+	"vol", "amount"}
+
+func (cli *Client) daily(ctx context.Context, api string, opts ...dailyOpt) ([]DailyTick, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call(api, args, []string{
-		"ts_code", "trade_date",
-		"open", "high", "low", "close",
-		"pre_close", "change", "pct_chg",
-		"vol", "amount"})
-	if err != nil {
-		return nil, err
-	}
-	var idxCode, idxDate int
-	var idxOpen, idxHigh, idxLow, idxClose int
-	var idxPreClose, idxChange, idxPctChg int
-	var idxVolume, idxAmount int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "trade_date":
-			idxDate = i
-		case "open":
-			idxOpen = i
-		case "high":
-			idxHigh = i
-		case "low":
-			idxLow = i
-		case "close":
-			idxClose = i
-		case "pre_close":
-			idxPreClose = i
-		case "change":
-			idxChange = i
-		case "pct_chg":
-			idxPctChg = i
-		case "vol":
-			idxVolume = i
-		case "amount":
-			idxAmount = i
-		}
-	}
-	items := make([]DailyTick, len(data))
-	toFloat := func(v any) float64 {
-		if v == nil {
-			return 0
-		}
-		return v.(float64)
-	}
-	for i, item := range data {
-		date, _ := time.ParseInLocation("20060102", item[idxDate].(string), time.Local)
-		items[i] = DailyTick{
-			Tick: Tick{
-				Code:     item[idxCode].(string),
-				Time:     date,
-				Open:     item[idxOpen].(float64),
-				High:     item[idxHigh].(float64),
-				Low:      item[idxLow].(float64),
-				Close:    item[idxClose].(float64),
-				Volume:   item[idxVolume].(float64),
-				Turnover: toFloat(item[idxAmount]),
-			},
-			PreClose: item[idxPreClose].(float64),
-			Change:   item[idxChange].(float64),
-			PctChg:   item[idxPctChg].(float64),
-		}
-	}
-	return items, nil
+	return query[DailyTick](ctx, cli, api, args, dailyFields)
 }
 
 // Daily 获取日线数据
 func (cli *Client) Daily(opts ...dailyOpt) ([]DailyTick, error) {
-	return cli.daily("daily", opts...)
+	return cli.DailyContext(context.Background(), opts...)
+}
+
+// DailyContext 获取日线数据
+func (cli *Client) DailyContext(ctx context.Context, opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.daily(ctx, "daily", opts...)
 }
 
 // DailyVip 获取VIP日线数据
 func (cli *Client) DailyVip(opts ...dailyOpt) ([]DailyTick, error) {
-	return cli.daily("daily_vip", opts...)
+	return cli.DailyVipContext(context.Background(), opts...)
+}
+
+// DailyVipContext 获取VIP日线数据
+func (cli *Client) DailyVipContext(ctx context.Context, opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.daily(ctx, "daily_vip", opts...)
+}
+
+// DailyIter 逐行获取日线数据，按页请求，停止迭代时不再请求后续数据
+func (cli *Client) DailyIter(opts ...dailyOpt) iter.Seq2[DailyTick, error] {
+	return cli.DailyIterContext(context.Background(), opts...)
+}
+
+// DailyIterContext 逐行获取日线数据，按页请求，停止迭代时不再请求后续数据
+func (cli *Client) DailyIterContext(ctx context.Context, opts ...dailyOpt) iter.Seq2[DailyTick, error] {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return iterRows[DailyTick](ctx, cli, "daily", args, dailyFields)
 }
 
 // WithDailyCode 按股票代码查询
@@ -119,14 +89,14 @@ func WithDailyCode(code string) dailyOpt {
 // WithDailyDate 按交易日期查询
 func WithDailyDate(date time.Time) dailyOpt {
 	return func(args Args) {
-		args["trade_date"] = date.Format("20060102")
+		args["trade_date"] = date
 	}
 }
 
 // WithDailyDateRange 按交易日期范围查询
 func WithDailyDateRange(start, end time.Time) dailyOpt {
 	return func(args Args) {
-		args["start_date"] = start.Format("20060102")
-		args["end_date"] = end.Format("20060102")
+		args["start_date"] = start
+		args["end_date"] = end
 	}
 }
@@ -0,0 +1,91 @@
+// https://tushare.pro/document/2?doc_id=32
+
+package tushare
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// DailyBasic 每日指标，Code及Time与DailyTick一致，可按(Code, Time)与日线数据关联
+type DailyBasic struct {
+	Columns       `json:"-"`
+	Code          string    `tushare:"ts_code"`         // 股票代码
+	Time          time.Time `tushare:"trade_date,date"` // 交易日期
+	Close         float64   `tushare:"close"`           // 收盘价
+	TurnoverRate  float64   `tushare:"turnover_rate"`   // 换手率(%)
+	TurnoverRateF float64   `tushare:"turnover_rate_f"` // 换手率(自由流通股)(%)
+	VolumeRatio   float64   `tushare:"volume_ratio"`    // 量比
+	PE            float64   `tushare:"pe"`              // 市盈率(总市值/净利润，亏损时为空)
+	PETTM         float64   `tushare:"pe_ttm"`          // 市盈率(TTM，亏损时为空)
+	PB            float64   `tushare:"pb"`              // 市净率(总市值/净资产)
+	PS            float64   `tushare:"ps"`              // 市销率
+	PSTTM         float64   `tushare:"ps_ttm"`          // 市销率(TTM)
+	DvRatio       float64   `tushare:"dv_ratio"`        // 股息率(%)
+	DvTTM         float64   `tushare:"dv_ttm"`          // 股息率(TTM)(%)
+	TotalShare    float64   `tushare:"total_share"`     // 总股本(万股)
+	FloatShare    float64   `tushare:"float_share"`     // 流通股本(万股)
+	FreeShare     float64   `tushare:"free_share"`      // 自由流通股本(万股)
+	TotalMV       float64   `tushare:"total_mv"`        // 总市值(万元)
+	CircMV        float64   `tushare:"circ_mv"`         // 流通市值(万元)
+}
+
+type dailyBasicOpt func(Args)
+
+var dailyBasicFields = []string{
+	"ts_code", "trade_date", "close",
+	"turnover_rate", "turnover_rate_f", "volume_ratio",
+	"pe", "pe_ttm", "pb", "ps", "ps_ttm", "dv_ratio", "dv_ttm",
+	"total_share", "float_share", "free_share", "total_mv", "circ_mv",
+}
+
+// DailyBasic 获取每日指标
+func (cli *Client) DailyBasic(opts ...dailyBasicOpt) ([]DailyBasic, error) {
+	return cli.DailyBasicContext(context.Background(), opts...)
+}
+
+// DailyBasicContext 获取每日指标
+func (cli *Client) DailyBasicContext(ctx context.Context, opts ...dailyBasicOpt) ([]DailyBasic, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return query[DailyBasic](ctx, cli, "daily_basic", args, dailyBasicFields)
+}
+
+// DailyBasicIter 逐行获取每日指标，按页请求，停止迭代时不再请求后续数据
+func (cli *Client) DailyBasicIter(opts ...dailyBasicOpt) iter.Seq2[DailyBasic, error] {
+	return cli.DailyBasicIterContext(context.Background(), opts...)
+}
+
+// DailyBasicIterContext 逐行获取每日指标，按页请求，停止迭代时不再请求后续数据
+func (cli *Client) DailyBasicIterContext(ctx context.Context, opts ...dailyBasicOpt) iter.Seq2[DailyBasic, error] {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return iterRows[DailyBasic](ctx, cli, "daily_basic", args, dailyBasicFields)
+}
+
+// WithDailyBasicCode 按股票代码查询
+func WithDailyBasicCode(code string) dailyBasicOpt {
+	return func(args Args) {
+		args["ts_code"] = code
+	}
+}
+
+// WithDailyBasicDate 按交易日期查询
+func WithDailyBasicDate(date time.Time) dailyBasicOpt {
+	return func(args Args) {
+		args["trade_date"] = date
+	}
+}
+
+// WithDailyBasicDateRange 按交易日期范围查询
+func WithDailyBasicDateRange(start, end time.Time) dailyBasicOpt {
+	return func(args Args) {
+		args["start_date"] = start
+		args["end_date"] = end
+	}
+}
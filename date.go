@@ -0,0 +1,108 @@
+package tushare
+
+import (
+	"fmt"
+	"time"
+)
+
+// Shanghai 北京时间，所有日期默认按此时区解析
+var Shanghai = loadShanghai()
+
+func loadShanghai() *time.Location {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		// 系统中没有时区数据库时使用固定的UTC+8，中国自1991年起不再实行夏令时
+		return time.FixedZone("CST", 8*60*60)
+	}
+	return loc
+}
+
+// Date 不含时间及时区的日期，可用于交易日等场景
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf 返回t所在时区的日期
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate 按yyyymmdd格式解析日期
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// String 按yyyymmdd格式输出日期
+func (d Date) String() string {
+	return fmt.Sprintf("%04d%02d%02d", d.Year, d.Month, d.Day)
+}
+
+// In 返回指定时区中当天零点的时间
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// IsZero 判断是否为零值
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// AddDays 返回n天后的日期
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Compare 比较两个日期，d早于other时返回-1，相等时返回0，晚于other时返回1
+func (d Date) Compare(other Date) int {
+	return d.In(time.UTC).Compare(other.In(time.UTC))
+}
+
+// Before 判断d是否早于other
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After 判断d是否晚于other
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// formatParam 将日期类型的参数按yyyymmdd格式转换为字符串，time.Time会先转换到loc时区
+func formatParam(v any, loc *time.Location) any {
+	switch v := v.(type) {
+	case time.Time:
+		return v.In(loc).Format("20060102")
+	case Date:
+		return v.String()
+	}
+	return v
+}
+
+// MarshalText 按yyyymmdd格式序列化，零值序列化为空字符串
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText 按yyyymmdd格式反序列化，空字符串反序列化为零值
+func (d *Date) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = Date{}
+		return nil
+	}
+	v, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,270 @@
+package tushare
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DecodeError 解析接口返回数据时的错误
+type DecodeError struct {
+	API    string // 接口名称，通过Decode直接解析时为空
+	Row    int    // 行号，列不存在时为-1
+	Column string // 列名
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	prefix := "decode"
+	if e.API != "" {
+		prefix = "decode " + e.API
+	}
+	if e.Row < 0 {
+		return fmt.Sprintf("%s column %s: %v", prefix, e.Column, e.Err)
+	}
+	return fmt.Sprintf("%s row %d column %s: %v", prefix, e.Row, e.Column, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Decode 按结构体字段的tushare标签将接口返回的数据解析为[]T，例如:
+//
+//	type Bar struct {
+//		Code  string    `tushare:"ts_code"`
+//		Date  time.Time `tushare:"trade_date,date"`
+//		Close float64   `tushare:"close"`
+//	}
+//
+// 标签中的date选项表示按yyyymmdd格式解析日期，datetime选项表示按yyyy-mm-dd hh:mm:ss格式解析时间，
+// time.Time使用Shanghai时区，也可以使用Date类型的字段，匿名嵌入的结构体会被展开，
+// 类型为Columns的字段会记录实际返回的列，
+// 值为null时解析为零值，以字符串返回的数字会被转换，
+// 列不存在或类型无法转换时返回*DecodeError
+func Decode[T any](fields []string, items [][]any) ([]T, error) {
+	return decode[T](fields, items, decodeConfig{strict: true, loc: Shanghai})
+}
+
+type decodeConfig struct {
+	api    string
+	strict bool     // 为false时忽略不存在的列及无法转换的值
+	want   []string // 请求的列，严格模式下仅检查这些列是否存在，为空时检查所有列
+	loc    *time.Location
+}
+
+func decode[T any](fields []string, items [][]any, cfg decodeConfig) ([]T, error) {
+	var zero T
+	plan, err := planOf(reflect.TypeOf(zero))
+	if err != nil {
+		return nil, err
+	}
+	cols := make([]int, len(plan.fields))
+	for i, p := range plan.fields {
+		cols[i] = slices.Index(fields, p.column)
+		if cols[i] >= 0 || !cfg.strict {
+			continue
+		}
+		if cfg.want == nil || slices.Contains(cfg.want, p.column) {
+			return nil, &DecodeError{API: cfg.api, Row: -1, Column: p.column, Err: errMissingColumn}
+		}
+	}
+	columns := reflect.ValueOf(newColumns(fields))
+	ret := make([]T, len(items))
+	for row, item := range items {
+		v := reflect.ValueOf(&ret[row]).Elem()
+		for _, index := range plan.columns {
+			v.FieldByIndex(index).Set(columns)
+		}
+		for i, p := range plan.fields {
+			if cols[i] < 0 {
+				continue
+			}
+			var value any
+			if cols[i] < len(item) {
+				value = item[cols[i]]
+			} else if cfg.strict {
+				return nil, &DecodeError{API: cfg.api, Row: row, Column: p.column, Err: errMissingColumn}
+			}
+			dst := v.FieldByIndex(p.index)
+			if err := p.set(dst, value, cfg.loc); err != nil {
+				if cfg.strict {
+					return nil, &DecodeError{API: cfg.api, Row: row, Column: p.column, Err: err}
+				}
+				dst.SetZero()
+			}
+		}
+	}
+	return ret, nil
+}
+
+// decodeRows 按客户端的配置解析接口返回的数据，want为请求的列
+func decodeRows[T any](cli *Client, api string, want, fields []string, items [][]any) ([]T, error) {
+	return decode[T](fields, items, decodeConfig{
+		api:    api,
+		strict: cli.strict,
+		want:   want,
+		loc:    cli.loc,
+	})
+}
+
+var errMissingColumn = errors.New("missing column")
+
+type fieldPlan struct {
+	index  []int
+	column string
+	layout string // 日期格式，为空时不是日期
+}
+
+type typePlan struct {
+	fields  []fieldPlan
+	columns [][]int // 类型为Columns的字段
+}
+
+var plans sync.Map // reflect.Type => *typePlan
+
+func planOf(t reflect.Type) (*typePlan, error) {
+	if p, ok := plans.Load(t); ok {
+		return p.(*typePlan), nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("decode: %s is not a struct", t)
+	}
+	plan := new(typePlan)
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := range t.NumField() {
+			f := t.Field(i)
+			idx := append(append([]int{}, index...), i)
+			if f.Type == columnsType {
+				plan.columns = append(plan.columns, idx)
+				continue
+			}
+			tag, ok := f.Tag.Lookup("tushare")
+			if !ok {
+				if f.Anonymous && f.Type.Kind() == reflect.Struct {
+					walk(f.Type, idx)
+				}
+				continue
+			}
+			if tag == "-" || !f.IsExported() {
+				continue
+			}
+			column, opt, _ := strings.Cut(tag, ",")
+			var layout string
+			switch {
+			case opt == "datetime":
+				layout = time.DateTime
+			case opt == "date", f.Type == timeType, f.Type == dateType:
+				layout = "20060102"
+			}
+			plan.fields = append(plan.fields, fieldPlan{
+				index:  idx,
+				column: column,
+				layout: layout,
+			})
+		}
+	}
+	walk(t, nil)
+	plans.Store(t, plan)
+	return plan, nil
+}
+
+var (
+	timeType    = reflect.TypeFor[time.Time]()
+	dateType    = reflect.TypeFor[Date]()
+	columnsType = reflect.TypeFor[Columns]()
+)
+
+func (p fieldPlan) set(dst reflect.Value, v any, loc *time.Location) error {
+	if v == nil {
+		dst.SetZero()
+		return nil
+	}
+	if p.layout != "" {
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			return fmt.Errorf("unexpected type %T for date", v)
+		}
+		if s == "" {
+			dst.SetZero()
+			return nil
+		}
+		t, err := time.ParseInLocation(p.layout, s, loc)
+		if err != nil {
+			return err
+		}
+		if dst.Type() == dateType {
+			dst.Set(reflect.ValueOf(DateOf(t)))
+			return nil
+		}
+		dst.Set(reflect.ValueOf(t))
+		return nil
+	}
+	switch dst.Kind() {
+	case reflect.String:
+		switch v := v.(type) {
+		case string:
+			dst.SetString(v)
+		case float64:
+			dst.SetString(strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			dst.SetString(strconv.FormatBool(v))
+		default:
+			return fmt.Errorf("unexpected type %T for string", v)
+		}
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat(v)
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, err := toFloat(v)
+		if err != nil {
+			return err
+		}
+		dst.SetInt(int64(f))
+	case reflect.Bool:
+		switch v := v.(type) {
+		case bool:
+			dst.SetBool(v)
+		case float64:
+			dst.SetBool(v != 0)
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			dst.SetBool(b)
+		default:
+			return fmt.Errorf("unexpected type %T for bool", v)
+		}
+	default:
+		return fmt.Errorf("unsupported field type %s", dst.Type())
+	}
+	return nil
+}
+
+func toFloat(v any) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		if v == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, fmt.Errorf("unexpected type %T for number", v)
+}
@@ -0,0 +1,217 @@
+package tushare_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+type decodeKind string
+
+type decodeBase struct {
+	Code string `tushare:"ts_code"`
+}
+
+type decodeBar struct {
+	tushare.Columns
+	decodeBase
+	Date   time.Time    `tushare:"trade_date,date"`
+	Day    tushare.Date `tushare:"cal_date"`
+	At     time.Time    `tushare:"trade_time,datetime"`
+	Volume int          `tushare:"vol"`
+	Kind   decodeKind   `tushare:"kind"`
+	Close  float64      `tushare:"close"`
+	Note   string       `tushare:"-"`
+}
+
+var decodeFields = []string{"ts_code", "trade_date", "cal_date", "trade_time", "vol", "kind", "close"}
+
+func TestDecode(t *testing.T) {
+	rows, err := tushare.Decode[decodeBar](decodeFields, [][]any{
+		{"000001.SZ", "20240102", "20240103", "2024-01-02 09:31:00", 1200., "1", 9.27},
+		{"000002.SZ", 20240103., nil, nil, "300", nil, "9.3"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "000001.SZ" || !got.Date.Equal(date(2024, 1, 2)) ||
+		got.Day != tushare.DateOf(date(2024, 1, 3)) ||
+		!got.At.Equal(time.Date(2024, 1, 2, 9, 31, 0, 0, tushare.Shanghai)) ||
+		got.Volume != 1200 || got.Kind != "1" || got.Close != 9.27 || got.Note != "" {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if got.Date.Location() != tushare.Shanghai {
+		t.Errorf("location = %v, want Shanghai", got.Date.Location())
+	}
+	for _, column := range decodeFields {
+		if !got.Has(column) {
+			t.Errorf("missing column %s", column)
+		}
+	}
+	// 数字格式的日期、以字符串返回的数字及null
+	got = rows[1]
+	if got.Code != "000002.SZ" || !got.Date.Equal(date(2024, 1, 3)) || !got.Day.IsZero() || !got.At.IsZero() ||
+		got.Volume != 300 || got.Kind != "" || got.Close != 9.3 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	full := []any{"000001.SZ", "20240102", "20240103", "2024-01-02 09:31:00", 1200., "1", 9.27}
+	with := func(i int, v any) []any {
+		row := append([]any{}, full...)
+		row[i] = v
+		return row
+	}
+	tests := []struct {
+		name   string
+		fields []string
+		items  [][]any
+		row    int
+		column string
+	}{
+		{"missing column", decodeFields[:6], [][]any{full[:6]}, -1, "close"},
+		{"missing embedded column", decodeFields[1:], [][]any{full[1:]}, -1, "ts_code"},
+		{"short row", decodeFields, [][]any{full, full[:5]}, 1, "kind"},
+		{"bad number", decodeFields, [][]any{full, with(6, "n/a")}, 1, "close"},
+		{"bad int", decodeFields, [][]any{with(4, true)}, 0, "vol"},
+		{"bad date", decodeFields, [][]any{full, full, with(1, "2024-01-02")}, 2, "trade_date"},
+		{"bad datetime", decodeFields, [][]any{with(3, "20240102")}, 0, "trade_time"},
+		{"bad enum", decodeFields, [][]any{with(5, []any{})}, 0, "kind"},
+	}
+	for _, tt := range tests {
+		_, err := tushare.Decode[decodeBar](tt.fields, tt.items)
+		var e *tushare.DecodeError
+		if !errors.As(err, &e) {
+			t.Errorf("%s: err = %v, want *DecodeError", tt.name, err)
+			continue
+		}
+		if e.API != "" || e.Row != tt.row || e.Column != tt.column {
+			t.Errorf("%s: err = %+v, want row %d column %s", tt.name, e, tt.row, tt.column)
+		}
+	}
+}
+
+func TestDecodeNotStruct(t *testing.T) {
+	if _, err := tushare.Decode[string]([]string{"ts_code"}, [][]any{{"000001.SZ"}}); err == nil {
+		t.Fatal("expected an error for a non-struct type")
+	}
+}
+
+// decodeServer 返回null、以字符串返回的数字及类型错误的值
+func decodeServer(t *testing.T) *tusharetest.Server {
+	t.Helper()
+	srv := tusharetest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddRows("repurchase", []string{"ts_code", "ann_date", "end_date", "proc", "vol", "amount", "high_limit"}, [][]any{
+		{"002415.SZ", "20240102", nil, nil, "2352700", "n/a", 31.5},
+		{"600000.SH", "20240102", "20240630", "实施", 100., 1000., nil},
+	})
+	// 停牌股票的开盘价等为null
+	srv.AddRows("daily", []string{"ts_code", "trade_date", "open", "high", "low", "close", "pre_close", "vol"}, [][]any{
+		{"000001.SZ", "20240102", nil, nil, nil, 9.39, 9.39, 0.},
+	})
+	return srv
+}
+
+func TestDecodeLenient(t *testing.T) {
+	srv := decodeServer(t)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL))
+	rows, err := cli.Repurchase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	// null的proc及end_date为零值，类型错误的amount被置为零值
+	got := rows[0]
+	if got.Code != "002415.SZ" || got.Proc != "" || !got.EndDate.IsZero() ||
+		got.Volume != 2352700 || got.Amount != 0 || got.High != 31.5 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if !got.Has("proc") || !got.Has("amount") {
+		t.Fatalf("columns = %v", got.Names())
+	}
+	if got := rows[1]; got.Proc != tushare.RepurchaseProcImplement || got.Amount != 1000 || got.High != 0 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+
+	ticks, err := cli.Daily()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 1 {
+		t.Fatalf("got %d rows, want 1", len(ticks))
+	}
+	if tick := ticks[0]; tick.Open != 0 || tick.High != 0 || tick.Close != 9.39 || tick.PreClose != 9.39 {
+		t.Fatalf("unexpected row %+v", tick)
+	}
+}
+
+func TestDecodeStrictClient(t *testing.T) {
+	srv := decodeServer(t)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithStrictDecode())
+	_, err := cli.Repurchase(tushare.WithFields("ts_code", "proc", "vol", "amount"))
+	var e *tushare.DecodeError
+	if !errors.As(err, &e) {
+		t.Fatalf("err = %v, want *DecodeError", err)
+	}
+	if e.API != "repurchase" || e.Row != 0 || e.Column != "amount" {
+		t.Fatalf("err = %+v", e)
+	}
+
+	// null不是错误
+	rows, err := cli.Repurchase(tushare.WithFields("ts_code", "proc", "vol"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows[0].Proc != "" || rows[0].Volume != 2352700 {
+		t.Fatalf("unexpected row %+v", rows[0])
+	}
+	ticks, err := cli.Daily(tushare.WithFields("ts_code", "trade_date", "open", "close"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticks[0].Open != 0 || ticks[0].Close != 9.39 {
+		t.Fatalf("unexpected row %+v", ticks[0])
+	}
+}
+
+func TestDecodeShortRows(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"code":0,"msg":"","data":{"fields":["ts_code","trade_date","open","close"],`+
+			`"items":[["000001.SZ","20240102",9.39,9.4],["000002.SZ","20240102"]],"has_more":false}}`)
+	}))
+	defer srv.Close()
+
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL))
+	ticks, err := cli.Daily(tushare.WithFields("ts_code", "trade_date", "open", "close"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 2 || ticks[0].Close != 9.4 {
+		t.Fatalf("unexpected rows %+v", ticks)
+	}
+	if got := ticks[1]; got.Code != "000002.SZ" || !got.Time.Equal(date(2024, 1, 2)) || got.Open != 0 || got.Close != 0 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+
+	cli = tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithStrictDecode())
+	_, err = cli.Daily(tushare.WithFields("ts_code", "trade_date", "open", "close"))
+	var e *tushare.DecodeError
+	if !errors.As(err, &e) || e.API != "daily" || e.Row != 1 || e.Column != "open" {
+		t.Fatalf("err = %v, want a *DecodeError for row 1 column open", err)
+	}
+}
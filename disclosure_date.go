@@ -0,0 +1,71 @@
+// https://tushare.pro/document/2?doc_id=162
+
+package tushare
+
+import (
+	"context"
+	"time"
+)
+
+// DisclosureDate 财报披露计划
+type DisclosureDate struct {
+	Columns    `json:"-"`
+	Code       string    `tushare:"ts_code"`          // 股票代码
+	AnnDate    time.Time `tushare:"ann_date,date"`    // 最新披露公告日
+	EndDate    time.Time `tushare:"end_date,date"`    // 报告期
+	PreDate    time.Time `tushare:"pre_date,date"`    // 预计披露日期
+	ActualDate time.Time `tushare:"actual_date,date"` // 实际披露日期，尚未披露时为零值
+	ModifyDate string    `tushare:"modify_date"`      // 披露日期修正记录
+}
+
+type disclosureDateOpt func(Args)
+
+// DisclosureDate 获取财报披露计划
+func (cli *Client) DisclosureDate(opts ...disclosureDateOpt) ([]DisclosureDate, error) {
+	return cli.DisclosureDateContext(context.Background(), opts...)
+}
+
+// DisclosureDateContext 获取财报披露计划
+func (cli *Client) DisclosureDateContext(ctx context.Context, opts ...disclosureDateOpt) ([]DisclosureDate, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return query[DisclosureDate](ctx, cli, "disclosure_date", args,
+		[]string{"ts_code", "ann_date", "end_date", "pre_date", "actual_date", "modify_date"})
+}
+
+// WithDisclosureDateCode 按股票代码查询
+func WithDisclosureDateCode(code string) disclosureDateOpt {
+	return func(args Args) {
+		args["ts_code"] = code
+	}
+}
+
+// WithDisclosureDatePeriod 按报告期查询，例如20231231表示年报，20230630表示半年报
+func WithDisclosureDatePeriod(period time.Time) disclosureDateOpt {
+	return func(args Args) {
+		args["end_date"] = period
+	}
+}
+
+// WithDisclosureDateAnnDate 按最新披露公告日查询
+func WithDisclosureDateAnnDate(date time.Time) disclosureDateOpt {
+	return func(args Args) {
+		args["ann_date"] = date
+	}
+}
+
+// WithDisclosureDatePreDate 按预计披露日期查询
+func WithDisclosureDatePreDate(date time.Time) disclosureDateOpt {
+	return func(args Args) {
+		args["pre_date"] = date
+	}
+}
+
+// WithDisclosureDateActualDate 按实际披露日期查询
+func WithDisclosureDateActualDate(date time.Time) disclosureDateOpt {
+	return func(args Args) {
+		args["actual_date"] = date
+	}
+}
@@ -0,0 +1,341 @@
+package tushare_test
+
+import (
+	"net/http"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+// 接口回归测试，从testdata中的录制文件回放，
+// 设置TUSHARE_RECORD及TUSHARE_TOKEN时请求真实接口并更新录制文件，
+// TUSHARE_BASE_URL可指定录制时使用的接口地址
+func replayClient(t *testing.T) *tushare.Client {
+	t.Helper()
+	baseURL := os.Getenv("TUSHARE_BASE_URL")
+	if baseURL == "" {
+		baseURL = tushare.DefaultBaseURL
+	}
+	rec := tusharetest.NewRecorder("testdata", tusharetest.ModeFromEnv())
+	return tushare.New(os.Getenv("TUSHARE_TOKEN"),
+		tushare.WithBaseURL(baseURL),
+		tushare.WithHTTPClient(rec.HTTPClient()),
+		tushare.WithStrictDecode())
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, tushare.Shanghai)
+}
+
+func TestDailyReplay(t *testing.T) {
+	cli := replayClient(t)
+	ticks, err := cli.Daily(tushare.WithDailyCode("000001.SZ"),
+		tushare.WithDailyDateRange(date(2024, 1, 2), date(2024, 1, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 4 {
+		t.Fatalf("got %d rows, want 4", len(ticks))
+	}
+	got := ticks[0]
+	if got.Code != "000001.SZ" || !got.Time.Equal(date(2024, 1, 5)) ||
+		got.Open != 9.19 || got.High != 9.33 || got.Low != 9.15 || got.Close != 9.27 ||
+		got.PreClose != 9.17 || got.Change != 0.1 || got.PctChg != 1.0905 ||
+		got.Volume != 1146034.03 || got.Turnover != 1065424.304 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if !got.Has("pct_chg") {
+		t.Fatalf("columns = %v", got.Names())
+	}
+}
+
+func TestAdjFactorReplay(t *testing.T) {
+	cli := replayClient(t)
+	factors, err := cli.AdjFactor(tushare.WithAdjustCode("000001.SZ"),
+		tushare.WithAdjustDateRange(date(2024, 1, 2), date(2024, 1, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(factors) != 4 {
+		t.Fatalf("got %d rows, want 4", len(factors))
+	}
+	for _, f := range factors {
+		if f.Code != "000001.SZ" || f.Factor != 108.031 {
+			t.Fatalf("unexpected row %+v", f)
+		}
+	}
+}
+
+func TestStockBasicReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.StockBasic(tushare.WithBasicCode("000001.SZ"),
+		tushare.WithExtraFields("market", "list_date"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "000001.SZ" || got.Symbol != "000001" || got.Name != "平安银行" ||
+		got.Area != "深圳" || got.Industry != "银行" || got.Market != tushare.BasicMarket主板 ||
+		!got.ListDate.Equal(date(1991, 4, 3)) {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if got.Has("fullname") {
+		t.Fatal("fullname was not requested")
+	}
+}
+
+func TestTradeCalReplay(t *testing.T) {
+	cli := replayClient(t)
+	days, err := cli.TradeCal(date(2024, 1, 1), date(2024, 1, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []time.Time{
+		date(2024, 1, 2), date(2024, 1, 3), date(2024, 1, 4), date(2024, 1, 5),
+		date(2024, 1, 8), date(2024, 1, 9), date(2024, 1, 10),
+	}
+	if !slices.EqualFunc(days, want, time.Time.Equal) {
+		t.Fatalf("TradeCal = %v, want %v", days, want)
+	}
+}
+
+func TestIndexWeightReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.IndexWeight("399300.SZ", tushare.WithIndexWeightDate(date(2024, 1, 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+	for _, row := range rows {
+		if row.IndexCode != "399300.SZ" || !row.Date.Equal(date(2024, 1, 2)) || row.Weight <= 0 {
+			t.Fatalf("unexpected row %+v", row)
+		}
+	}
+	if rows[0].Code != "600519.SH" || rows[0].Weight != 5.7423 {
+		t.Fatalf("unexpected row %+v", rows[0])
+	}
+}
+
+func TestThsMemberReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.ThsMember(tushare.WithThsMemberIndexCode("885800.TI"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].IndexCode != "885800.TI" || rows[0].StockCode != "300001.SZ" || rows[0].StockName != "特锐德" {
+		t.Fatalf("unexpected row %+v", rows[0])
+	}
+}
+
+func TestThsDailyReplay(t *testing.T) {
+	cli := replayClient(t)
+	ticks, err := cli.ThsDaily(tushare.WithThsDailyCode("885800.TI"),
+		tushare.WithThsDailyDate(date(2024, 1, 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 1 {
+		t.Fatalf("got %d rows, want 1", len(ticks))
+	}
+	got := ticks[0]
+	if got.Code != "885800.TI" || got.Close != 1283.417 || got.PctChg != -1.2734 || got.Volume != 2419340300 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if !got.Has("pct_chg") || got.Has("pct_change") || got.Has("amount") {
+		t.Fatalf("columns = %v", got.Names())
+	}
+}
+
+func TestRepurchaseReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.Repurchase(tushare.WithRepurchaseAnnDate(date(2024, 1, 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "002415.SZ" || got.Proc != tushare.RepurchaseProcImplement ||
+		!got.AnnDate.Equal(date(2024, 1, 2)) || got.Volume != 2352700 || got.Amount != 72860100 ||
+		got.High != 31.5 || got.Low != 30.61 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if rows[1].Proc != tushare.RepurchaseProcPrepare || !rows[1].EndDate.IsZero() {
+		t.Fatalf("unexpected row %+v", rows[1])
+	}
+}
+
+func TestMoneyFlowReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.MoneyFlow(tushare.WithMoneyFlowCode("000001.SZ"),
+		tushare.WithMoneyFlowDate(date(2024, 1, 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	if got := rows[0]; got.BuySmVol != 101873 || got.NetMfAmt != -8926.32 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+}
+
+func TestDailyBasicReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.DailyBasic(tushare.WithDailyBasicCode("000001.SZ"),
+		tushare.WithDailyBasicDate(date(2024, 1, 2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "000001.SZ" || !got.Time.Equal(date(2024, 1, 2)) ||
+		got.PE != 4.0931 || got.PB != 0.5044 || got.TotalMV != 17872856.0953 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+}
+
+func TestIncomeReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.Income(tushare.WithReportCode("000001.SZ"),
+		tushare.WithReportPeriod(date(2023, 12, 31)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 接口返回更正前后两条数据，仅保留更正后的数据
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	got := rows[0]
+	if got.UpdateFlag != "1" || got.ReportType != tushare.ReportType合并报表 ||
+		got.CompType != tushare.ReportCompType银行 || got.Revenue != 164699000000 ||
+		got.NIncomeAttrP != 46455000000 || !got.EndDate.Equal(date(2023, 12, 31)) {
+		t.Fatalf("unexpected row %+v", got.Report)
+	}
+}
+
+func TestForecastReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.Forecast(tushare.WithForecastAnnDate(date(2024, 1, 20)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].Type != tushare.ForecastType预增 || rows[0].PChangeMin != 50 || rows[0].PChangeMax != 70 {
+		t.Fatalf("unexpected row %+v", rows[0])
+	}
+	if rows[1].Type != tushare.ForecastType首亏 || rows[1].NetProfitMax != -12000 {
+		t.Fatalf("unexpected row %+v", rows[1])
+	}
+}
+
+func TestForecastVipReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.ForecastVip(tushare.WithForecastPeriod(date(2023, 12, 31)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "000001.SZ" || got.Type != tushare.ForecastType略增 ||
+		!got.EndDate.Equal(date(2023, 12, 31)) || got.PChangeMin != 5 || got.PChangeMax != 15 {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	if rows[1].Code != "600570.SH" || rows[1].Type != tushare.ForecastType预增 {
+		t.Fatalf("unexpected row %+v", rows[1])
+	}
+}
+
+func TestExpressReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.Express(tushare.WithExpressCode("600000.SH"),
+		tushare.WithExpressAnnDateRange(date(2024, 1, 1), date(2024, 1, 31)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "600000.SH" || !got.AnnDate.Equal(date(2024, 1, 30)) || !got.EndDate.Equal(date(2023, 12, 31)) ||
+		got.Revenue != 173434000000 || got.NIncome != 36702000000 || got.DilutedEPS != 1.08 ||
+		got.YoySales != -8.02 || got.IsAudit != 0 || got.PerfSummary == "" {
+		t.Fatalf("unexpected row %+v", got)
+	}
+}
+
+func TestExpressVipReplay(t *testing.T) {
+	cli := replayClient(t)
+	rows, err := cli.ExpressVip(tushare.WithExpressPeriod(date(2023, 12, 31)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].Code != "000001.SZ" || rows[0].IsAudit != 1 || rows[0].BPS != 22.04 {
+		t.Fatalf("unexpected row %+v", rows[0])
+	}
+	// 未披露的指标为null
+	if rows[1].Code != "600000.SH" || rows[1].YoyROE != 0 || !rows[1].Has("yoy_roe") {
+		t.Fatalf("unexpected row %+v", rows[1])
+	}
+}
+
+func TestDisclosureDateReplay(t *testing.T) {
+	cli := replayClient(t)
+	// 录制文件按参数区分，回放成功说明报告期以end_date参数传递
+	rows, err := cli.DisclosureDate(tushare.WithDisclosureDatePeriod(date(2023, 12, 31)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	got := rows[0]
+	if got.Code != "000001.SZ" || !got.EndDate.Equal(date(2023, 12, 31)) ||
+		!got.PreDate.Equal(date(2024, 3, 15)) || !got.ActualDate.Equal(date(2024, 3, 15)) {
+		t.Fatalf("unexpected row %+v", got)
+	}
+	// 尚未披露时actual_date为null
+	if got := rows[1]; got.Code != "600000.SH" || !got.ActualDate.IsZero() ||
+		!got.PreDate.Equal(date(2024, 4, 27)) || got.ModifyDate != "20240427,20240329" {
+		t.Fatalf("unexpected row %+v", got)
+	}
+}
+
+func TestDisclosureDatePeriodParam(t *testing.T) {
+	srv := tusharetest.NewServer()
+	defer srv.Close()
+	srv.AddRows("disclosure_date", []string{"ts_code", "end_date", "actual_date"}, [][]any{
+		{"000001.SZ", "20231231", nil},
+	})
+	rec := &paramRecorder{params: make(map[string]map[string]any)}
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithHTTPClient(&http.Client{Transport: rec}))
+	if _, err := cli.DisclosureDate(tushare.WithDisclosureDatePeriod(date(2023, 12, 31))); err != nil {
+		t.Fatal(err)
+	}
+	params := rec.get("disclosure_date")
+	if params["end_date"] != "20231231" || params["period"] != nil {
+		t.Fatalf("params = %v", params)
+	}
+}
@@ -0,0 +1,102 @@
+package tushare
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrRateLimited 接口访问频率超限
+	ErrRateLimited = errors.New("tushare: rate limited")
+	// ErrNoPermission 没有接口访问权限(积分不足等)
+	ErrNoPermission = errors.New("tushare: no permission")
+	// ErrInvalidToken token无效
+	ErrInvalidToken = errors.New("tushare: invalid token")
+	// ErrInvalidParams 请求参数错误
+	ErrInvalidParams = errors.New("tushare: invalid params")
+)
+
+// APIError 接口返回的错误
+type APIError struct {
+	Code   int    // tushare返回的错误码
+	Msg    string // tushare返回的错误信息
+	API    string // 接口名称
+	Params Args   // 请求参数
+	Status int    // HTTP状态码
+}
+
+func (e *APIError) Error() string {
+	if e.Code == 0 {
+		return fmt.Sprintf("%s: http: %d", e.API, e.Status)
+	}
+	return fmt.Sprintf("%s: code: %d, %s", e.API, e.Code, e.Msg)
+}
+
+// Is 支持通过errors.Is判断ErrRateLimited、ErrNoPermission、ErrInvalidToken及ErrInvalidParams
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return classify(e.Code, e.Msg) == kindRateLimited
+	case ErrNoPermission:
+		return classify(e.Code, e.Msg) == kindNoPermission
+	case ErrInvalidToken:
+		return classify(e.Code, e.Msg) == kindInvalidToken
+	case ErrInvalidParams:
+		return classify(e.Code, e.Msg) == kindInvalidParams
+	}
+	return false
+}
+
+// RetryError 重试结束后返回的错误，记录了请求次数及最后一次的错误
+type RetryError struct {
+	Attempts int   // 请求次数
+	Err      error // 最后一次请求的错误
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+type errKind int
+
+const (
+	kindUnknown errKind = iota
+	kindRateLimited
+	kindNoPermission
+	kindInvalidToken
+	kindInvalidParams
+)
+
+// classify 根据tushare返回的错误码及错误信息判断错误类型
+func classify(code int, msg string) errKind {
+	switch code {
+	case 0:
+		return kindUnknown
+	case 40101, -2002:
+		return kindInvalidToken
+	case -2001:
+		return kindInvalidParams
+	case 40203:
+		// 频率超限与无权限使用相同的错误码，只能通过错误信息区分
+		for _, s := range []string{"每分钟", "每小时", "每天", "最多访问"} {
+			if strings.Contains(msg, s) {
+				return kindRateLimited
+			}
+		}
+		return kindNoPermission
+	}
+	switch {
+	case strings.Contains(msg, "token"):
+		return kindInvalidToken
+	case strings.Contains(msg, "权限"), strings.Contains(msg, "积分"):
+		return kindNoPermission
+	case strings.Contains(msg, "最多访问"):
+		return kindRateLimited
+	}
+	return kindUnknown
+}
@@ -2,78 +2,45 @@
 
 package tushare
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // ETFBasic 获取ETF列表
 type ETFBasic struct {
-	Code      string      // ETF代码
-	Name      string      // ETF名称
-	IndexCode string      // 关联指数代码
-	IndexName string      // 关联指数名称
-	Date      time.Time   // 上市日期
-	Status    etfStatus   // ETF状态
-	Exchange  etfExchange // 交易所
+	Columns    `json:"-"`
+	Code       string      `tushare:"ts_code"`         // ETF代码
+	Name       string      `tushare:"csname"`          // ETF名称
+	ExtName    string      `tushare:"extname"`         // ETF扩位简称
+	FullName   string      `tushare:"cname"`           // 基金中文全称
+	IndexCode  string      `tushare:"index_code"`      // 关联指数代码
+	IndexName  string      `tushare:"index_name"`      // 关联指数名称
+	SetupDate  time.Time   `tushare:"setup_date,date"` // 设立日期
+	Date       time.Time   `tushare:"list_date,date"`  // 上市日期
+	Status     etfStatus   `tushare:"list_status"`     // ETF状态
+	Exchange   etfExchange `tushare:"exchange"`        // 交易所
+	MgrName    string      `tushare:"mgr_name"`        // 基金管理人
+	CustodName string      `tushare:"custod_name"`     // 基金托管人
+	MgtFee     float64     `tushare:"mgt_fee"`         // 基金管理费率
+	Type       string      `tushare:"etf_type"`        // 基金投资通道类型(境内/QDII)
 }
 
 type etfOpt func(Args)
 
 // ETFBasic 获取ETF列表
 func (cli *Client) ETFBasic(opts ...etfOpt) ([]ETFBasic, error) {
+	return cli.ETFBasicContext(context.Background(), opts...)
+}
+
+// ETFBasicContext 获取ETF列表
+func (cli *Client) ETFBasicContext(ctx context.Context, opts ...etfOpt) ([]ETFBasic, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call("etf_basic", args,
+	return query[ETFBasic](ctx, cli, "etf_basic", args,
 		[]string{"ts_code", "csname", "index_code", "index_name", "list_date", "list_status", "exchange"})
-	if err != nil {
-		return nil, err
-	}
-	var idxCode, idxName, idxIndexCode, idxIndexName, idxDate, idxStatus, idxExchange int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "csname":
-			idxName = i
-		case "index_code":
-			idxIndexCode = i
-		case "index_name":
-			idxIndexName = i
-		case "list_date":
-			idxDate = i
-		case "list_status":
-			idxStatus = i
-		case "exchange":
-			idxExchange = i
-		}
-	}
-	items := make([]ETFBasic, len(data))
-	toString := func(v any) string {
-		if v == nil {
-			return ""
-		}
-		return v.(string)
-	}
-	toDate := func(v any) time.Time {
-		if v == nil {
-			return time.Time{}
-		}
-		s := v.(string)
-		t, _ := time.Parse("20060102", s)
-		return t
-	}
-	for i, item := range data {
-		items[i] = ETFBasic{
-			Code:      toString(item[idxCode]),
-			Name:      toString(item[idxName]),
-			IndexCode: toString(item[idxIndexCode]),
-			IndexName: toString(item[idxIndexName]),
-			Date:      toDate(item[idxDate]),
-			Status:    etfStatus(toString(item[idxStatus])),
-			Exchange:  etfExchange(toString(item[idxExchange])),
-		}
-	}
-	return items, nil
 }
 
 // WithETFCode 按ETF代码查询
@@ -93,7 +60,7 @@ func WithETFIndexCode(indexCode string) etfOpt {
 // WithETFDate 按上市日期查询
 func WithETFDate(date time.Time) etfOpt {
 	return func(args Args) {
-		args["list_date"] = date.Format("20060102")
+		args["list_date"] = date
 	}
 }
 
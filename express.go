@@ -0,0 +1,114 @@
+// https://tushare.pro/document/2?doc_id=46
+
+package tushare
+
+import (
+	"context"
+	"time"
+)
+
+// Express 业绩快报，金额单位为元
+type Express struct {
+	Columns               `json:"-"`
+	Code                  string    `tushare:"ts_code"`                    // 股票代码
+	AnnDate               time.Time `tushare:"ann_date,date"`              // 公告日期
+	EndDate               time.Time `tushare:"end_date,date"`              // 报告期
+	Revenue               float64   `tushare:"revenue"`                    // 营业收入
+	OperateProfit         float64   `tushare:"operate_profit"`             // 营业利润
+	TotalProfit           float64   `tushare:"total_profit"`               // 利润总额
+	NIncome               float64   `tushare:"n_income"`                   // 净利润
+	TotalAssets           float64   `tushare:"total_assets"`               // 总资产
+	TotalHldrEqyExcMinInt float64   `tushare:"total_hldr_eqy_exc_min_int"` // 股东权益合计(不含少数股东权益)
+	DilutedEPS            float64   `tushare:"diluted_eps"`                // 每股收益(摊薄)
+	DilutedROE            float64   `tushare:"diluted_roe"`                // 净资产收益率(摊薄)(%)
+	YoyNetProfit          float64   `tushare:"yoy_net_profit"`             // 去年同期修正后净利润
+	BPS                   float64   `tushare:"bps"`                        // 每股净资产
+	YoySales              float64   `tushare:"yoy_sales"`                  // 同比增长率:营业收入(%)
+	YoyOp                 float64   `tushare:"yoy_op"`                     // 同比增长率:营业利润(%)
+	YoyTp                 float64   `tushare:"yoy_tp"`                     // 同比增长率:利润总额(%)
+	YoyDeduNp             float64   `tushare:"yoy_dedu_np"`                // 同比增长率:归属母公司股东的净利润(%)
+	YoyEPS                float64   `tushare:"yoy_eps"`                    // 同比增长率:基本每股收益(%)
+	YoyROE                float64   `tushare:"yoy_roe"`                    // 同比增减:加权平均净资产收益率(%)
+	GrowthAssets          float64   `tushare:"growth_assets"`              // 比年初增长率:总资产(%)
+	YoyEquity             float64   `tushare:"yoy_equity"`                 // 比年初增长率:归属母公司的股东权益(%)
+	GrowthBPS             float64   `tushare:"growth_bps"`                 // 比年初增长率:归属于母公司股东的每股净资产(%)
+	OrLastYear            float64   `tushare:"or_last_year"`               // 去年同期营业收入
+	OpLastYear            float64   `tushare:"op_last_year"`               // 去年同期营业利润
+	TpLastYear            float64   `tushare:"tp_last_year"`               // 去年同期利润总额
+	NpLastYear            float64   `tushare:"np_last_year"`               // 去年同期净利润
+	EPSLastYear           float64   `tushare:"eps_last_year"`              // 去年同期每股收益
+	OpenNetAssets         float64   `tushare:"open_net_assets"`            // 期初净资产
+	OpenBPS               float64   `tushare:"open_bps"`                   // 期初每股净资产
+	PerfSummary           string    `tushare:"perf_summary"`               // 业绩简要说明
+	IsAudit               int       `tushare:"is_audit"`                   // 是否审计(1是/0否)
+	Remark                string    `tushare:"remark"`                     // 备注
+}
+
+type expressOpt func(Args)
+
+var expressFields = []string{
+	"ts_code", "ann_date", "end_date",
+	"revenue", "operate_profit", "total_profit", "n_income", "total_assets",
+	"total_hldr_eqy_exc_min_int", "diluted_eps", "diluted_roe", "yoy_net_profit", "bps",
+	"yoy_sales", "yoy_op", "yoy_tp", "yoy_dedu_np", "yoy_eps", "yoy_roe",
+	"growth_assets", "yoy_equity", "growth_bps",
+	"or_last_year", "op_last_year", "tp_last_year", "np_last_year", "eps_last_year",
+	"open_net_assets", "open_bps", "perf_summary", "is_audit", "remark",
+}
+
+// Express 获取业绩快报
+func (cli *Client) Express(opts ...expressOpt) ([]Express, error) {
+	return cli.ExpressContext(context.Background(), opts...)
+}
+
+// ExpressContext 获取业绩快报
+func (cli *Client) ExpressContext(ctx context.Context, opts ...expressOpt) ([]Express, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return query[Express](ctx, cli, "express", args, expressFields)
+}
+
+// ExpressVip 获取VIP业绩快报，可按报告期获取全部股票的数据
+func (cli *Client) ExpressVip(opts ...expressOpt) ([]Express, error) {
+	return cli.ExpressVipContext(context.Background(), opts...)
+}
+
+// ExpressVipContext 获取VIP业绩快报，可按报告期获取全部股票的数据
+func (cli *Client) ExpressVipContext(ctx context.Context, opts ...expressOpt) ([]Express, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return query[Express](ctx, cli, "express_vip", args, expressFields)
+}
+
+// WithExpressCode 按股票代码查询
+func WithExpressCode(code string) expressOpt {
+	return func(args Args) {
+		args["ts_code"] = code
+	}
+}
+
+// WithExpressPeriod 按报告期查询，例如20231231表示年报，20230630表示半年报
+func WithExpressPeriod(period time.Time) expressOpt {
+	return func(args Args) {
+		args["period"] = period
+	}
+}
+
+// WithExpressAnnDate 按公告日期查询
+func WithExpressAnnDate(date time.Time) expressOpt {
+	return func(args Args) {
+		args["ann_date"] = date
+	}
+}
+
+// WithExpressAnnDateRange 按公告日期范围查询
+func WithExpressAnnDateRange(start, end time.Time) expressOpt {
+	return func(args Args) {
+		args["start_date"] = start
+		args["end_date"] = end
+	}
+}
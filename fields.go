@@ -0,0 +1,79 @@
+package tushare
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	fieldsKey      = "\x00fields"
+	extraFieldsKey = "\x00extra_fields"
+)
+
+// WithFields 仅获取指定的列，可用于所有列表查询接口，
+// 未获取的列在结构体中为零值，可通过Columns.Has判断
+func WithFields(fields ...string) func(Args) {
+	return func(args Args) {
+		args[fieldsKey] = fields
+	}
+}
+
+// WithExtraFields 在默认列的基础上额外获取指定的列，可用于所有列表查询接口，
+// 例如WithExtraFields("list_date", "market")
+func WithExtraFields(fields ...string) func(Args) {
+	return func(args Args) {
+		extra, _ := args[extraFieldsKey].([]string)
+		args[extraFieldsKey] = append(extra, fields...)
+	}
+}
+
+// selectFields 根据WithFields及WithExtraFields计算需要获取的列，并从args中删除对应的参数
+func selectFields(args Args, fields []string) []string {
+	if v, ok := args[fieldsKey].([]string); ok {
+		fields = v
+	}
+	if extra, ok := args[extraFieldsKey].([]string); ok {
+		fields = slices.Clone(fields)
+		for _, field := range extra {
+			if !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
+	}
+	delete(args, fieldsKey)
+	delete(args, extraFieldsKey)
+	return fields
+}
+
+// Columns 记录接口实际返回的列，用于区分未获取的列与零值，
+// 返回相同列的两行数据中Columns相等，不影响结构体之间使用==比较，序列化为JSON时忽略
+type Columns struct {
+	names string // 排序后以逗号连接的列名
+}
+
+func newColumns(fields []string) Columns {
+	names := slices.Clone(fields)
+	slices.Sort(names)
+	return Columns{names: strings.Join(slices.Compact(names), ",")}
+}
+
+// Has 判断是否返回了指定的列
+func (c Columns) Has(column string) bool {
+	if c.names == "" {
+		return false
+	}
+	for name := range strings.SplitSeq(c.names, ",") {
+		if name == column {
+			return true
+		}
+	}
+	return false
+}
+
+// Names 返回接口实际返回的所有列
+func (c Columns) Names() []string {
+	if c.names == "" {
+		return nil
+	}
+	return strings.Split(c.names, ",")
+}
@@ -0,0 +1,37 @@
+package tushare
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestColumnsEquality(t *testing.T) {
+	item := []any{"000001.SZ", "20240102", 10.5}
+	a, err := decode[DailyTick]([]string{"ts_code", "trade_date", "close"}, [][]any{item}, decodeConfig{loc: Shanghai})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 列的顺序不同的另一页数据
+	b, err := decode[DailyTick]([]string{"close", "ts_code", "trade_date"}, [][]any{{10.5, "000001.SZ", "20240102"}}, decodeConfig{loc: Shanghai})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a[0] != b[0] {
+		t.Fatalf("rows from different pages are not equal: %+v != %+v", a[0], b[0])
+	}
+	if !a[0].Has("close") || a[0].Has("open") {
+		t.Fatalf("Has: got columns %v", a[0].Names())
+	}
+	if got := a[0].Names(); !slices.Equal(got, []string{"close", "trade_date", "ts_code"}) {
+		t.Fatalf("Names = %v", got)
+	}
+	data, err := json.Marshal(a[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "Columns") {
+		t.Fatalf("Columns encoded to JSON: %s", data)
+	}
+}
@@ -0,0 +1,242 @@
+// https://tushare.pro/document/2?doc_id=79
+
+package tushare
+
+import (
+	"context"
+	"time"
+)
+
+// FinaIndicator 财务指标，比率类指标的单位为%
+type FinaIndicator struct {
+	Columns                `json:"-"`
+	Code                   string    `tushare:"ts_code"`                  // 股票代码
+	AnnDate                time.Time `tushare:"ann_date,date"`            // 公告日期
+	EndDate                time.Time `tushare:"end_date,date"`            // 报告期
+	UpdateFlag             string    `tushare:"update_flag"`              // 更新标识(0未修改/1更正后的数据)
+	EPS                    float64   `tushare:"eps"`                      // 基本每股收益
+	DtEPS                  float64   `tushare:"dt_eps"`                   // 稀释每股收益
+	TotalRevenuePS         float64   `tushare:"total_revenue_ps"`         // 每股营业总收入
+	RevenuePS              float64   `tushare:"revenue_ps"`               // 每股营业收入
+	CapitalResePS          float64   `tushare:"capital_rese_ps"`          // 每股资本公积
+	SurplusResePS          float64   `tushare:"surplus_rese_ps"`          // 每股盈余公积
+	UndistProfitPS         float64   `tushare:"undist_profit_ps"`         // 每股未分配利润
+	ExtraItem              float64   `tushare:"extra_item"`               // 非经常性损益
+	ProfitDedt             float64   `tushare:"profit_dedt"`              // 扣除非经常性损益后的净利润(扣非净利润)
+	GrossMargin            float64   `tushare:"gross_margin"`             // 毛利
+	CurrentRatio           float64   `tushare:"current_ratio"`            // 流动比率
+	QuickRatio             float64   `tushare:"quick_ratio"`              // 速动比率
+	CashRatio              float64   `tushare:"cash_ratio"`               // 保守速动比率
+	InvturnDays            float64   `tushare:"invturn_days"`             // 存货周转天数
+	ArturnDays             float64   `tushare:"arturn_days"`              // 应收账款周转天数
+	InvTurn                float64   `tushare:"inv_turn"`                 // 存货周转率
+	ArTurn                 float64   `tushare:"ar_turn"`                  // 应收账款周转率
+	CaTurn                 float64   `tushare:"ca_turn"`                  // 流动资产周转率
+	FaTurn                 float64   `tushare:"fa_turn"`                  // 固定资产周转率
+	AssetsTurn             float64   `tushare:"assets_turn"`              // 总资产周转率
+	OpIncome               float64   `tushare:"op_income"`                // 经营活动净收益
+	EBIT                   float64   `tushare:"ebit"`                     // 息税前利润
+	EBITDA                 float64   `tushare:"ebitda"`                   // 息税折旧摊销前利润
+	FCFF                   float64   `tushare:"fcff"`                     // 企业自由现金流量
+	FCFE                   float64   `tushare:"fcfe"`                     // 股权自由现金流量
+	CurrentExint           float64   `tushare:"current_exint"`            // 无息流动负债
+	NoncurrentExint        float64   `tushare:"noncurrent_exint"`         // 无息非流动负债
+	InterestDebt           float64   `tushare:"interestdebt"`             // 带息债务
+	NetDebt                float64   `tushare:"netdebt"`                  // 净债务
+	TangibleAsset          float64   `tushare:"tangible_asset"`           // 有形资产
+	WorkingCapital         float64   `tushare:"working_capital"`          // 营运资金
+	NetworkingCapital      float64   `tushare:"networking_capital"`       // 营运流动资本
+	InvestCapital          float64   `tushare:"invest_capital"`           // 全部投入资本
+	RetainedEarnings       float64   `tushare:"retained_earnings"`        // 留存收益
+	Diluted2EPS            float64   `tushare:"diluted2_eps"`             // 期末摊薄每股收益
+	BPS                    float64   `tushare:"bps"`                      // 每股净资产
+	OCFPS                  float64   `tushare:"ocfps"`                    // 每股经营活动产生的现金流量净额
+	RetainedPS             float64   `tushare:"retainedps"`               // 每股留存收益
+	CFPS                   float64   `tushare:"cfps"`                     // 每股现金流量净额
+	EBITPS                 float64   `tushare:"ebit_ps"`                  // 每股息税前利润
+	FCFFPS                 float64   `tushare:"fcff_ps"`                  // 每股企业自由现金流量
+	FCFEPS                 float64   `tushare:"fcfe_ps"`                  // 每股股东自由现金流量
+	NetprofitMargin        float64   `tushare:"netprofit_margin"`         // 销售净利率
+	GrossprofitMargin      float64   `tushare:"grossprofit_margin"`       // 销售毛利率
+	CogsOfSales            float64   `tushare:"cogs_of_sales"`            // 销售成本率
+	ExpenseOfSales         float64   `tushare:"expense_of_sales"`         // 销售期间费用率
+	ProfitToGr             float64   `tushare:"profit_to_gr"`             // 净利润/营业总收入
+	SaleexpToGr            float64   `tushare:"saleexp_to_gr"`            // 销售费用/营业总收入
+	AdminexpOfGr           float64   `tushare:"adminexp_of_gr"`           // 管理费用/营业总收入
+	FinaexpOfGr            float64   `tushare:"finaexp_of_gr"`            // 财务费用/营业总收入
+	ImpaiTTM               float64   `tushare:"impai_ttm"`                // 资产减值损失/营业总收入
+	GcOfGr                 float64   `tushare:"gc_of_gr"`                 // 营业总成本/营业总收入
+	OpOfGr                 float64   `tushare:"op_of_gr"`                 // 营业利润/营业总收入
+	EBITOfGr               float64   `tushare:"ebit_of_gr"`               // 息税前利润/营业总收入
+	ROE                    float64   `tushare:"roe"`                      // 净资产收益率
+	ROEWaa                 float64   `tushare:"roe_waa"`                  // 加权平均净资产收益率
+	ROEDt                  float64   `tushare:"roe_dt"`                   // 净资产收益率(扣除非经常损益)
+	ROA                    float64   `tushare:"roa"`                      // 总资产报酬率
+	NPTA                   float64   `tushare:"npta"`                     // 总资产净利润
+	ROIC                   float64   `tushare:"roic"`                     // 投入资本回报率
+	ROEYearly              float64   `tushare:"roe_yearly"`               // 年化净资产收益率
+	ROA2Yearly             float64   `tushare:"roa2_yearly"`              // 年化总资产报酬率
+	ROEAvg                 float64   `tushare:"roe_avg"`                  // 平均净资产收益率(增发条件)
+	OpincomeOfEBT          float64   `tushare:"opincome_of_ebt"`          // 经营活动净收益/利润总额
+	InvestincomeOfEBT      float64   `tushare:"investincome_of_ebt"`      // 价值变动净收益/利润总额
+	NOpProfitOfEBT         float64   `tushare:"n_op_profit_of_ebt"`       // 营业外收支净额/利润总额
+	TaxToEBT               float64   `tushare:"tax_to_ebt"`               // 所得税/利润总额
+	DtprofitToProfit       float64   `tushare:"dtprofit_to_profit"`       // 扣除非经常损益后的净利润/净利润
+	SalescashToOr          float64   `tushare:"salescash_to_or"`          // 销售商品提供劳务收到的现金/营业收入
+	OCFToOr                float64   `tushare:"ocf_to_or"`                // 经营活动产生的现金流量净额/营业收入
+	OCFToOpincome          float64   `tushare:"ocf_to_opincome"`          // 经营活动产生的现金流量净额/经营活动净收益
+	CapitalizedToDa        float64   `tushare:"capitalized_to_da"`        // 资本支出/折旧和摊销
+	DebtToAssets           float64   `tushare:"debt_to_assets"`           // 资产负债率
+	AssetsToEqt            float64   `tushare:"assets_to_eqt"`            // 权益乘数
+	DpAssetsToEqt          float64   `tushare:"dp_assets_to_eqt"`         // 权益乘数(杜邦分析)
+	CaToAssets             float64   `tushare:"ca_to_assets"`             // 流动资产/总资产
+	NcaToAssets            float64   `tushare:"nca_to_assets"`            // 非流动资产/总资产
+	TbassetsToTotalassets  float64   `tushare:"tbassets_to_totalassets"`  // 有形资产/总资产
+	IntToTalcap            float64   `tushare:"int_to_talcap"`            // 带息债务/全部投入资本
+	EqtToTalcapital        float64   `tushare:"eqt_to_talcapital"`        // 归属于母公司的股东权益/全部投入资本
+	CurrentdebtToDebt      float64   `tushare:"currentdebt_to_debt"`      // 流动负债/负债合计
+	LongdebToDebt          float64   `tushare:"longdeb_to_debt"`          // 非流动负债/负债合计
+	OCFToShortdebt         float64   `tushare:"ocf_to_shortdebt"`         // 经营活动产生的现金流量净额/流动负债
+	DebtToEqt              float64   `tushare:"debt_to_eqt"`              // 产权比率
+	EqtToDebt              float64   `tushare:"eqt_to_debt"`              // 归属于母公司的股东权益/负债合计
+	EqtToInterestdebt      float64   `tushare:"eqt_to_interestdebt"`      // 归属于母公司的股东权益/带息债务
+	TangibleassetToDebt    float64   `tushare:"tangibleasset_to_debt"`    // 有形资产/负债合计
+	TangassetToIntdebt     float64   `tushare:"tangasset_to_intdebt"`     // 有形资产/带息债务
+	TangibleassetToNetdebt float64   `tushare:"tangibleasset_to_netdebt"` // 有形资产/净债务
+	OCFToDebt              float64   `tushare:"ocf_to_debt"`              // 经营活动产生的现金流量净额/负债合计
+	TurnDays               float64   `tushare:"turn_days"`                // 营业周期
+	ROAYearly              float64   `tushare:"roa_yearly"`               // 年化总资产净利率
+	ROADp                  float64   `tushare:"roa_dp"`                   // 总资产净利率(杜邦分析)
+	FixedAssets            float64   `tushare:"fixed_assets"`             // 固定资产合计
+	ProfitToOp             float64   `tushare:"profit_to_op"`             // 利润总额/营业收入
+	QSalesYoy              float64   `tushare:"q_sales_yoy"`              // 营业收入同比增长率(单季度)(%)
+	QOpQoq                 float64   `tushare:"q_op_qoq"`                 // 营业利润环比增长率(单季度)(%)
+	BasicEPSYoy            float64   `tushare:"basic_eps_yoy"`            // 基本每股收益同比增长率(%)
+	DtEPSYoy               float64   `tushare:"dt_eps_yoy"`               // 稀释每股收益同比增长率(%)
+	CFPSYoy                float64   `tushare:"cfps_yoy"`                 // 每股经营活动产生的现金流量净额同比增长率(%)
+	OpYoy                  float64   `tushare:"op_yoy"`                   // 营业利润同比增长率(%)
+	EBTYoy                 float64   `tushare:"ebt_yoy"`                  // 利润总额同比增长率(%)
+	NetprofitYoy           float64   `tushare:"netprofit_yoy"`            // 归属母公司股东的净利润同比增长率(%)
+	DtNetprofitYoy         float64   `tushare:"dt_netprofit_yoy"`         // 归属母公司股东的净利润(扣非)同比增长率(%)
+	OCFYoy                 float64   `tushare:"ocf_yoy"`                  // 经营活动产生的现金流量净额同比增长率(%)
+	ROEYoy                 float64   `tushare:"roe_yoy"`                  // 净资产收益率(摊薄)同比增长率(%)
+	BPSYoy                 float64   `tushare:"bps_yoy"`                  // 每股净资产相对年初增长率(%)
+	AssetsYoy              float64   `tushare:"assets_yoy"`               // 资产总计相对年初增长率(%)
+	EqtYoy                 float64   `tushare:"eqt_yoy"`                  // 归属母公司的股东权益相对年初增长率(%)
+	TrYoy                  float64   `tushare:"tr_yoy"`                   // 营业总收入同比增长率(%)
+	OrYoy                  float64   `tushare:"or_yoy"`                   // 营业收入同比增长率(%)
+	EquityYoy              float64   `tushare:"equity_yoy"`               // 净资产同比增长率(%)
+}
+
+func (f FinaIndicator) report() Report {
+	return Report{Code: f.Code, AnnDate: f.AnnDate, EndDate: f.EndDate, UpdateFlag: f.UpdateFlag}
+}
+
+var finaIndicatorFields = []string{
+	"ts_code", "ann_date", "end_date", "update_flag",
+	"eps", "dt_eps", "total_revenue_ps", "revenue_ps", "capital_rese_ps", "surplus_rese_ps",
+	"undist_profit_ps", "extra_item", "profit_dedt", "gross_margin", "current_ratio",
+	"quick_ratio", "cash_ratio", "invturn_days", "arturn_days", "inv_turn", "ar_turn",
+	"ca_turn", "fa_turn", "assets_turn", "op_income", "ebit", "ebitda", "fcff", "fcfe",
+	"current_exint", "noncurrent_exint", "interestdebt", "netdebt", "tangible_asset",
+	"working_capital", "networking_capital", "invest_capital", "retained_earnings",
+	"diluted2_eps", "bps", "ocfps", "retainedps", "cfps", "ebit_ps", "fcff_ps", "fcfe_ps",
+	"netprofit_margin", "grossprofit_margin", "cogs_of_sales", "expense_of_sales",
+	"profit_to_gr", "saleexp_to_gr", "adminexp_of_gr", "finaexp_of_gr", "impai_ttm",
+	"gc_of_gr", "op_of_gr", "ebit_of_gr", "roe", "roe_waa", "roe_dt", "roa", "npta", "roic",
+	"roe_yearly", "roa2_yearly", "roe_avg", "opincome_of_ebt", "investincome_of_ebt",
+	"n_op_profit_of_ebt", "tax_to_ebt", "dtprofit_to_profit", "salescash_to_or",
+	"ocf_to_or", "ocf_to_opincome", "capitalized_to_da", "debt_to_assets", "assets_to_eqt",
+	"dp_assets_to_eqt", "ca_to_assets", "nca_to_assets", "tbassets_to_totalassets",
+	"int_to_talcap", "eqt_to_talcapital", "currentdebt_to_debt", "longdeb_to_debt",
+	"ocf_to_shortdebt", "debt_to_eqt", "eqt_to_debt", "eqt_to_interestdebt",
+	"tangibleasset_to_debt", "tangasset_to_intdebt", "tangibleasset_to_netdebt",
+	"ocf_to_debt", "turn_days", "roa_yearly", "roa_dp", "fixed_assets", "profit_to_op",
+	"q_sales_yoy", "q_op_qoq", "basic_eps_yoy", "dt_eps_yoy", "cfps_yoy", "op_yoy",
+	"ebt_yoy", "netprofit_yoy", "dt_netprofit_yoy", "ocf_yoy", "roe_yoy", "bps_yoy",
+	"assets_yoy", "eqt_yoy", "tr_yoy", "or_yoy", "equity_yoy",
+}
+
+type finaIndicatorOpt func(Args)
+
+// FinaIndicator 获取财务指标，需指定股票代码，已被更正的数据会被去除
+func (cli *Client) FinaIndicator(opts ...finaIndicatorOpt) ([]FinaIndicator, error) {
+	return cli.FinaIndicatorContext(context.Background(), opts...)
+}
+
+// FinaIndicatorContext 获取财务指标，需指定股票代码，已被更正的数据会被去除
+func (cli *Client) FinaIndicatorContext(ctx context.Context, opts ...finaIndicatorOpt) ([]FinaIndicator, error) {
+	return report[FinaIndicator](ctx, cli, "fina_indicator", finaIndicatorFields, opts...)
+}
+
+// FinaIndicatorVip 获取VIP财务指标，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) FinaIndicatorVip(opts ...finaIndicatorOpt) ([]FinaIndicator, error) {
+	return cli.FinaIndicatorVipContext(context.Background(), opts...)
+}
+
+// FinaIndicatorVipContext 获取VIP财务指标，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) FinaIndicatorVipContext(ctx context.Context, opts ...finaIndicatorOpt) ([]FinaIndicator, error) {
+	return report[FinaIndicator](ctx, cli, "fina_indicator_vip", finaIndicatorFields, opts...)
+}
+
+// FinaIndicatorAsOf 获取每家公司在date(含)之前公告的最新一期财务指标，不会使用date之后才公告或更正的数据，
+// 仅查询start至date之间公告的数据，start为零值时不限制开始日期，opts中的查询条件优先，
+// 未指定股票代码时使用fina_indicator_vip接口获取全部股票的数据
+func (cli *Client) FinaIndicatorAsOf(start, date time.Time, opts ...finaIndicatorOpt) ([]FinaIndicator, error) {
+	return cli.FinaIndicatorAsOfContext(context.Background(), start, date, opts...)
+}
+
+// FinaIndicatorAsOfContext 获取每家公司在date(含)之前公告的最新一期财务指标，不会使用date之后才公告或更正的数据，
+// 仅查询start至date之间公告的数据，start为零值时不限制开始日期，opts中的查询条件优先，
+// 未指定股票代码时使用fina_indicator_vip接口获取全部股票的数据
+func (cli *Client) FinaIndicatorAsOfContext(ctx context.Context, start, date time.Time, opts ...finaIndicatorOpt) ([]FinaIndicator, error) {
+	opts = append([]finaIndicatorOpt{func(args Args) {
+		if !start.IsZero() {
+			args["start_date"] = start
+		}
+		args["end_date"] = date
+	}}, opts...)
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	api := "fina_indicator"
+	if _, ok := args["ts_code"]; !ok {
+		api = "fina_indicator_vip"
+	}
+	// 按原始数据过滤，避免date之后更正的数据替换公告时的数据
+	rows, err := rawReports[FinaIndicator](ctx, cli, api, finaIndicatorFields, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ReportsAsOf(rows, date), nil
+}
+
+// WithFinaIndicatorCode 按股票代码查询
+func WithFinaIndicatorCode(code string) finaIndicatorOpt {
+	return func(args Args) {
+		args["ts_code"] = code
+	}
+}
+
+// WithFinaIndicatorPeriod 按报告期查询，例如20231231表示年报，20230630表示半年报
+func WithFinaIndicatorPeriod(period time.Time) finaIndicatorOpt {
+	return func(args Args) {
+		args["period"] = period
+	}
+}
+
+// WithFinaIndicatorAnnDate 按公告日期查询
+func WithFinaIndicatorAnnDate(date time.Time) finaIndicatorOpt {
+	return func(args Args) {
+		args["ann_date"] = date
+	}
+}
+
+// WithFinaIndicatorAnnDateRange 按公告日期范围查询
+func WithFinaIndicatorAnnDateRange(start, end time.Time) finaIndicatorOpt {
+	return func(args Args) {
+		args["start_date"] = start
+		args["end_date"] = end
+	}
+}
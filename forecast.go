@@ -0,0 +1,111 @@
+// https://tushare.pro/document/2?doc_id=45
+
+package tushare
+
+import (
+	"context"
+	"time"
+)
+
+// Forecast 业绩预告
+type Forecast struct {
+	Columns       `json:"-"`
+	Code          string       `tushare:"ts_code"`             // 股票代码
+	AnnDate       time.Time    `tushare:"ann_date,date"`       // 公告日期
+	EndDate       time.Time    `tushare:"end_date,date"`       // 报告期
+	Type          forecastType `tushare:"type"`                // 业绩预告类型
+	PChangeMin    float64      `tushare:"p_change_min"`        // 预告净利润变动幅度下限(%)
+	PChangeMax    float64      `tushare:"p_change_max"`        // 预告净利润变动幅度上限(%)
+	NetProfitMin  float64      `tushare:"net_profit_min"`      // 预告净利润下限(万元)
+	NetProfitMax  float64      `tushare:"net_profit_max"`      // 预告净利润上限(万元)
+	LastParentNet float64      `tushare:"last_parent_net"`     // 上年同期归属母公司净利润
+	FirstAnnDate  time.Time    `tushare:"first_ann_date,date"` // 首次公告日
+	Summary       string       `tushare:"summary"`             // 业绩预告摘要
+	ChangeReason  string       `tushare:"change_reason"`       // 业绩变动原因
+}
+
+type forecastOpt func(Args)
+
+var forecastFields = []string{
+	"ts_code", "ann_date", "end_date", "type",
+	"p_change_min", "p_change_max", "net_profit_min", "net_profit_max",
+	"last_parent_net", "first_ann_date", "summary", "change_reason",
+}
+
+// Forecast 获取业绩预告
+func (cli *Client) Forecast(opts ...forecastOpt) ([]Forecast, error) {
+	return cli.ForecastContext(context.Background(), opts...)
+}
+
+// ForecastContext 获取业绩预告
+func (cli *Client) ForecastContext(ctx context.Context, opts ...forecastOpt) ([]Forecast, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return query[Forecast](ctx, cli, "forecast", args, forecastFields)
+}
+
+// ForecastVip 获取VIP业绩预告，可按报告期获取全部股票的数据
+func (cli *Client) ForecastVip(opts ...forecastOpt) ([]Forecast, error) {
+	return cli.ForecastVipContext(context.Background(), opts...)
+}
+
+// ForecastVipContext 获取VIP业绩预告，可按报告期获取全部股票的数据
+func (cli *Client) ForecastVipContext(ctx context.Context, opts ...forecastOpt) ([]Forecast, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	return query[Forecast](ctx, cli, "forecast_vip", args, forecastFields)
+}
+
+// WithForecastCode 按股票代码查询
+func WithForecastCode(code string) forecastOpt {
+	return func(args Args) {
+		args["ts_code"] = code
+	}
+}
+
+// WithForecastPeriod 按报告期查询，例如20231231表示年报，20230630表示半年报
+func WithForecastPeriod(period time.Time) forecastOpt {
+	return func(args Args) {
+		args["period"] = period
+	}
+}
+
+// WithForecastAnnDate 按公告日期查询
+func WithForecastAnnDate(date time.Time) forecastOpt {
+	return func(args Args) {
+		args["ann_date"] = date
+	}
+}
+
+// WithForecastAnnDateRange 按公告日期范围查询
+func WithForecastAnnDateRange(start, end time.Time) forecastOpt {
+	return func(args Args) {
+		args["start_date"] = start
+		args["end_date"] = end
+	}
+}
+
+type forecastType string
+
+const (
+	ForecastType预增  forecastType = "预增"
+	ForecastType预减  forecastType = "预减"
+	ForecastType扭亏  forecastType = "扭亏"
+	ForecastType首亏  forecastType = "首亏"
+	ForecastType续亏  forecastType = "续亏"
+	ForecastType续盈  forecastType = "续盈"
+	ForecastType略增  forecastType = "略增"
+	ForecastType略减  forecastType = "略减"
+	ForecastType不确定 forecastType = "不确定"
+)
+
+// WithForecastType 按业绩预告类型查询
+func WithForecastType(t forecastType) forecastOpt {
+	return func(args Args) {
+		args["type"] = t
+	}
+}
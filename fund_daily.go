@@ -2,7 +2,14 @@
 
 package tushare
 
+import "context"
+
 // FundDaily 获取ETF每日行情
 func (cli *Client) FundDaily(opts ...dailyOpt) ([]DailyTick, error) {
-	return cli.daily("fund_daily", opts...)
+	return cli.FundDailyContext(context.Background(), opts...)
+}
+
+// FundDailyContext 获取ETF每日行情
+func (cli *Client) FundDailyContext(ctx context.Context, opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.daily(ctx, "fund_daily", opts...)
 }
@@ -0,0 +1,143 @@
+// https://tushare.pro/document/2?doc_id=33
+
+package tushare
+
+import (
+	"context"
+	"slices"
+)
+
+// Income 利润表，金额单位为元
+type Income struct {
+	Columns `json:"-"`
+	Report
+	BasicEPS               float64 `tushare:"basic_eps"`                 // 基本每股收益
+	DilutedEPS             float64 `tushare:"diluted_eps"`               // 稀释每股收益
+	TotalRevenue           float64 `tushare:"total_revenue"`             // 营业总收入
+	Revenue                float64 `tushare:"revenue"`                   // 营业收入
+	IntIncome              float64 `tushare:"int_income"`                // 利息收入
+	PremEarned             float64 `tushare:"prem_earned"`               // 已赚保费
+	CommIncome             float64 `tushare:"comm_income"`               // 手续费及佣金收入
+	NCommisIncome          float64 `tushare:"n_commis_income"`           // 手续费及佣金净收入
+	NOthIncome             float64 `tushare:"n_oth_income"`              // 其他经营净收益
+	NOthBIncome            float64 `tushare:"n_oth_b_income"`            // 加:其他业务净收益
+	PremIncome             float64 `tushare:"prem_income"`               // 保险业务收入
+	OutPrem                float64 `tushare:"out_prem"`                  // 减:分出保费
+	UnePremReser           float64 `tushare:"une_prem_reser"`            // 提取未到期责任准备金
+	ReinsIncome            float64 `tushare:"reins_income"`              // 其中:分保费收入
+	NSecTbIncome           float64 `tushare:"n_sec_tb_income"`           // 代理买卖证券业务净收入
+	NSecUwIncome           float64 `tushare:"n_sec_uw_income"`           // 证券承销业务净收入
+	NAssetMgIncome         float64 `tushare:"n_asset_mg_income"`         // 受托客户资产管理业务净收入
+	OthBIncome             float64 `tushare:"oth_b_income"`              // 其他业务收入
+	FvValueChgGain         float64 `tushare:"fv_value_chg_gain"`         // 加:公允价值变动净收益
+	InvestIncome           float64 `tushare:"invest_income"`             // 加:投资净收益
+	AssInvestIncome        float64 `tushare:"ass_invest_income"`         // 其中:对联营企业和合营企业的投资收益
+	ForexGain              float64 `tushare:"forex_gain"`                // 加:汇兑净收益
+	TotalCOGS              float64 `tushare:"total_cogs"`                // 营业总成本
+	OperCost               float64 `tushare:"oper_cost"`                 // 减:营业成本
+	IntExp                 float64 `tushare:"int_exp"`                   // 减:利息支出
+	CommExp                float64 `tushare:"comm_exp"`                  // 减:手续费及佣金支出
+	BizTaxSurchg           float64 `tushare:"biz_tax_surchg"`            // 减:营业税金及附加
+	SellExp                float64 `tushare:"sell_exp"`                  // 减:销售费用
+	AdminExp               float64 `tushare:"admin_exp"`                 // 减:管理费用
+	FinExp                 float64 `tushare:"fin_exp"`                   // 减:财务费用
+	AssetsImpairLoss       float64 `tushare:"assets_impair_loss"`        // 减:资产减值损失
+	PremRefund             float64 `tushare:"prem_refund"`               // 退保金
+	CompensPayout          float64 `tushare:"compens_payout"`            // 赔付总支出
+	ReserInsurLiab         float64 `tushare:"reser_insur_liab"`          // 提取保险责任准备金
+	DivPayt                float64 `tushare:"div_payt"`                  // 保户红利支出
+	ReinsExp               float64 `tushare:"reins_exp"`                 // 分保费用
+	OperExp                float64 `tushare:"oper_exp"`                  // 营业支出
+	CompensPayoutRefu      float64 `tushare:"compens_payout_refu"`       // 减:摊回赔付支出
+	InsurReserRefu         float64 `tushare:"insur_reser_refu"`          // 减:摊回保险责任准备金
+	ReinsCostRefund        float64 `tushare:"reins_cost_refund"`         // 减:摊回分保费用
+	OtherBusCost           float64 `tushare:"other_bus_cost"`            // 其他业务成本
+	OperateProfit          float64 `tushare:"operate_profit"`            // 营业利润
+	NonOperIncome          float64 `tushare:"non_oper_income"`           // 加:营业外收入
+	NonOperExp             float64 `tushare:"non_oper_exp"`              // 减:营业外支出
+	NcaDisploss            float64 `tushare:"nca_disploss"`              // 其中:减:非流动资产处置净损失
+	TotalProfit            float64 `tushare:"total_profit"`              // 利润总额
+	IncomeTax              float64 `tushare:"income_tax"`                // 所得税费用
+	NIncome                float64 `tushare:"n_income"`                  // 净利润(含少数股东损益)
+	NIncomeAttrP           float64 `tushare:"n_income_attr_p"`           // 净利润(不含少数股东损益)
+	MinorityGain           float64 `tushare:"minority_gain"`             // 少数股东损益
+	OthComprIncome         float64 `tushare:"oth_compr_income"`          // 其他综合收益
+	TComprIncome           float64 `tushare:"t_compr_income"`            // 综合收益总额
+	ComprIncAttrP          float64 `tushare:"compr_inc_attr_p"`          // 归属于母公司(或股东)的综合收益总额
+	ComprIncAttrMS         float64 `tushare:"compr_inc_attr_m_s"`        // 归属于少数股东的综合收益总额
+	EBIT                   float64 `tushare:"ebit"`                      // 息税前利润
+	EBITDA                 float64 `tushare:"ebitda"`                    // 息税折旧摊销前利润
+	InsuranceExp           float64 `tushare:"insurance_exp"`             // 保险业务支出
+	UndistProfit           float64 `tushare:"undist_profit"`             // 年初未分配利润
+	DistableProfit         float64 `tushare:"distable_profit"`           // 可分配利润
+	RdExp                  float64 `tushare:"rd_exp"`                    // 研发费用
+	FinExpIntExp           float64 `tushare:"fin_exp_int_exp"`           // 财务费用:利息费用
+	FinExpIntInc           float64 `tushare:"fin_exp_int_inc"`           // 财务费用:利息收入
+	TransferSurplusRese    float64 `tushare:"transfer_surplus_rese"`     // 盈余公积转入
+	TransferHousingImprest float64 `tushare:"transfer_housing_imprest"`  // 住房周转金转入
+	TransferOth            float64 `tushare:"transfer_oth"`              // 其他转入
+	AdjLossgain            float64 `tushare:"adj_lossgain"`              // 调整以前年度损益
+	WithdraLegalSurplus    float64 `tushare:"withdra_legal_surplus"`     // 提取法定盈余公积
+	WithdraLegalPubfund    float64 `tushare:"withdra_legal_pubfund"`     // 提取法定公益金
+	WithdraBizDevfund      float64 `tushare:"withdra_biz_devfund"`       // 提取企业发展基金
+	WithdraReseFund        float64 `tushare:"withdra_rese_fund"`         // 提取储备基金
+	WithdraOthErsu         float64 `tushare:"withdra_oth_ersu"`          // 提取任意盈余公积金
+	WorkersWelfare         float64 `tushare:"workers_welfare"`           // 职工奖金福利
+	DistrProfitShrhder     float64 `tushare:"distr_profit_shrhder"`      // 可供股东分配的利润
+	PrfsharePayableDvd     float64 `tushare:"prfshare_payable_dvd"`      // 应付优先股股利
+	ComsharePayableDvd     float64 `tushare:"comshare_payable_dvd"`      // 应付普通股股利
+	CapitComstockDiv       float64 `tushare:"capit_comstock_div"`        // 转作股本的普通股股利
+	NetAfterNrLpCorrect    float64 `tushare:"net_after_nr_lp_correct"`   // 扣除非经常性损益后的净利润(更正前)
+	CreditImpaLoss         float64 `tushare:"credit_impa_loss"`          // 信用减值损失
+	NetExpoHedgingBenefits float64 `tushare:"net_expo_hedging_benefits"` // 净敞口套期收益
+	OthImpairLossAssets    float64 `tushare:"oth_impair_loss_assets"`    // 其他资产减值损失
+	TotalOpcost            float64 `tushare:"total_opcost"`              // 营业总成本(二)
+	AmodcostFinAssets      float64 `tushare:"amodcost_fin_assets"`       // 以摊余成本计量的金融资产终止确认收益
+	OthIncome              float64 `tushare:"oth_income"`                // 其他收益
+	AssetDispIncome        float64 `tushare:"asset_disp_income"`         // 资产处置收益
+	ContinuedNetProfit     float64 `tushare:"continued_net_profit"`      // 持续经营净利润
+	EndNetProfit           float64 `tushare:"end_net_profit"`            // 终止经营净利润
+}
+
+var incomeFields = slices.Concat(reportFields, []string{
+	"basic_eps", "diluted_eps", "total_revenue", "revenue", "int_income", "prem_earned",
+	"comm_income", "n_commis_income", "n_oth_income", "n_oth_b_income", "prem_income",
+	"out_prem", "une_prem_reser", "reins_income", "n_sec_tb_income", "n_sec_uw_income",
+	"n_asset_mg_income", "oth_b_income", "fv_value_chg_gain", "invest_income",
+	"ass_invest_income", "forex_gain", "total_cogs", "oper_cost", "int_exp", "comm_exp",
+	"biz_tax_surchg", "sell_exp", "admin_exp", "fin_exp", "assets_impair_loss",
+	"prem_refund", "compens_payout", "reser_insur_liab", "div_payt", "reins_exp",
+	"oper_exp", "compens_payout_refu", "insur_reser_refu", "reins_cost_refund",
+	"other_bus_cost", "operate_profit", "non_oper_income", "non_oper_exp", "nca_disploss",
+	"total_profit", "income_tax", "n_income", "n_income_attr_p", "minority_gain",
+	"oth_compr_income", "t_compr_income", "compr_inc_attr_p", "compr_inc_attr_m_s", "ebit",
+	"ebitda", "insurance_exp", "undist_profit", "distable_profit", "rd_exp",
+	"fin_exp_int_exp", "fin_exp_int_inc", "transfer_surplus_rese",
+	"transfer_housing_imprest", "transfer_oth", "adj_lossgain", "withdra_legal_surplus",
+	"withdra_legal_pubfund", "withdra_biz_devfund", "withdra_rese_fund", "withdra_oth_ersu",
+	"workers_welfare", "distr_profit_shrhder", "prfshare_payable_dvd",
+	"comshare_payable_dvd", "capit_comstock_div", "net_after_nr_lp_correct",
+	"credit_impa_loss", "net_expo_hedging_benefits", "oth_impair_loss_assets",
+	"total_opcost", "amodcost_fin_assets", "oth_income", "asset_disp_income",
+	"continued_net_profit", "end_net_profit",
+})
+
+// Income 获取利润表，已被更正的数据会被去除
+func (cli *Client) Income(opts ...reportOpt) ([]Income, error) {
+	return cli.IncomeContext(context.Background(), opts...)
+}
+
+// IncomeContext 获取利润表，已被更正的数据会被去除
+func (cli *Client) IncomeContext(ctx context.Context, opts ...reportOpt) ([]Income, error) {
+	return report[Income](ctx, cli, "income", incomeFields, opts...)
+}
+
+// IncomeVip 获取VIP利润表，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) IncomeVip(opts ...reportOpt) ([]Income, error) {
+	return cli.IncomeVipContext(context.Background(), opts...)
+}
+
+// IncomeVipContext 获取VIP利润表，可按报告期获取全部股票的数据，已被更正的数据会被去除
+func (cli *Client) IncomeVipContext(ctx context.Context, opts ...reportOpt) ([]Income, error) {
+	return report[Income](ctx, cli, "income_vip", incomeFields, opts...)
+}
@@ -2,60 +2,44 @@
 
 package tushare
 
+import (
+	"context"
+	"time"
+)
+
 // IndexBasic 指数基本信息
 type IndexBasic struct {
-	Code     string        // 指数代码
-	Name     string        // 指数名称
-	FullName string        // 指数全称
-	Market   indexMarket   // 市场
-	Category indexCategory // 分类
+	Columns    `json:"-"`
+	Code       string        `tushare:"ts_code"`        // 指数代码
+	Name       string        `tushare:"name"`           // 指数名称
+	FullName   string        `tushare:"fullname"`       // 指数全称
+	Market     indexMarket   `tushare:"market"`         // 市场
+	Publisher  string        `tushare:"publisher"`      // 发布方
+	IndexType  string        `tushare:"index_type"`     // 指数风格
+	Category   indexCategory `tushare:"category"`       // 分类
+	BaseDate   time.Time     `tushare:"base_date,date"` // 基期
+	BasePoint  float64       `tushare:"base_point"`     // 基点
+	ListDate   time.Time     `tushare:"list_date,date"` // 发布日期
+	WeightRule string        `tushare:"weight_rule"`    // 加权方式
+	Desc       string        `tushare:"desc"`           // 描述
+	ExpDate    time.Time     `tushare:"exp_date,date"`  // 终止日期
 }
 
 type indexBasicOpt func(Args)
 
 // IndexBasic 获取指数列表
 func (cli *Client) IndexBasic(opts ...indexBasicOpt) ([]IndexBasic, error) {
+	return cli.IndexBasicContext(context.Background(), opts...)
+}
+
+// IndexBasicContext 获取指数列表
+func (cli *Client) IndexBasicContext(ctx context.Context, opts ...indexBasicOpt) ([]IndexBasic, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call("index_basic", args,
+	return query[IndexBasic](ctx, cli, "index_basic", args,
 		[]string{"ts_code", "name", "fullname", "market", "category"})
-	if err != nil {
-		return nil, err
-	}
-	var idxCode, idxName, idxFullName, idxMarket, idxCategory int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "name":
-			idxName = i
-		case "fullname":
-			idxFullName = i
-		case "market":
-			idxMarket = i
-		case "category":
-			idxCategory = i
-		}
-	}
-	items := make([]IndexBasic, len(data))
-	toString := func(v any) string {
-		if v == nil {
-			return ""
-		}
-		return v.(string)
-	}
-	for i, item := range data {
-		items[i] = IndexBasic{
-			Code:     toString(item[idxCode]),
-			Name:     toString(item[idxName]),
-			FullName: toString(item[idxFullName]),
-			Market:   indexMarket(toString(item[idxMarket])),
-			Category: indexCategory(toString(item[idxCategory])),
-		}
-	}
-	return items, nil
 }
 
 type indexMarket string
@@ -0,0 +1,43 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/lwch/tushare"
+)
+
+// ATRStream 真实波幅的简单移动平均，常用参数为14
+//
+//	TR:=MAX(MAX(HIGH-LOW,ABS(REF(CLOSE,1)-HIGH)),ABS(REF(CLOSE,1)-LOW))
+//	ATR:MA(TR,N)
+//
+// 首条数据的TR为HIGH-LOW
+type ATRStream struct {
+	w       *window
+	prev    float64
+	started bool
+}
+
+// NewATRStream 创建n周期的ATR指标
+func NewATRStream(n int) *ATRStream {
+	return &ATRStream{w: newWindow(n)}
+}
+
+// Next 输入一条数据，数据不足n条时返回NaN
+func (s *ATRStream) Next(t tushare.DailyTick) Value {
+	tr := t.High - t.Low
+	if s.started {
+		tr = max(tr, math.Abs(s.prev-t.High), math.Abs(s.prev-t.Low))
+	}
+	s.prev, s.started = t.Close, true
+	s.w.push(tr)
+	if !s.w.full() {
+		return Value{Time: t.Time, Value: math.NaN()}
+	}
+	return Value{Time: t.Time, Value: s.w.mean()}
+}
+
+// ATR 计算n周期的ATR指标
+func ATR(ticks []tushare.DailyTick, n int) []Value {
+	return collect(NewATRStream(n), ticks)
+}
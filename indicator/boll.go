@@ -0,0 +1,48 @@
+package indicator
+
+import (
+	"math"
+	"time"
+
+	"github.com/lwch/tushare"
+)
+
+// BOLLValue 布林线指标
+type BOLLValue struct {
+	Time  time.Time
+	Mid   float64 // MA(CLOSE,N)
+	Upper float64 // MID+P*STD(CLOSE,N)
+	Lower float64 // MID-P*STD(CLOSE,N)
+}
+
+// BOLLStream 布林线指标，常用参数为20,2，标准差为样本标准差
+type BOLLStream struct {
+	w *window
+	p float64
+}
+
+// NewBOLLStream 创建n周期、p倍标准差的布林线指标
+func NewBOLLStream(n int, p float64) *BOLLStream {
+	return &BOLLStream{w: newWindow(n), p: p}
+}
+
+// Next 输入一条数据，数据不足n条时返回NaN
+func (s *BOLLStream) Next(t tushare.DailyTick) BOLLValue {
+	s.w.push(t.Close)
+	if !s.w.full() {
+		nan := math.NaN()
+		return BOLLValue{Time: t.Time, Mid: nan, Upper: nan, Lower: nan}
+	}
+	mid, std := s.w.mean(), s.w.std()
+	return BOLLValue{
+		Time:  t.Time,
+		Mid:   mid,
+		Upper: mid + s.p*std,
+		Lower: mid - s.p*std,
+	}
+}
+
+// BOLL 计算布林线指标
+func BOLL(ticks []tushare.DailyTick, n int, p float64) []BOLLValue {
+	return collect(NewBOLLStream(n, p), ticks)
+}
@@ -0,0 +1,39 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/lwch/tushare"
+)
+
+// CCIStream 顺势指标，常用参数为14
+//
+//	TYP:=(HIGH+LOW+CLOSE)/3
+//	CCI:(TYP-MA(TYP,N))/(0.015*AVEDEV(TYP,N))
+type CCIStream struct {
+	w *window
+}
+
+// NewCCIStream 创建n周期的CCI指标
+func NewCCIStream(n int) *CCIStream {
+	return &CCIStream{w: newWindow(n)}
+}
+
+// Next 输入一条数据，数据不足n条时返回NaN
+func (s *CCIStream) Next(t tushare.DailyTick) Value {
+	typ := (t.High + t.Low + t.Close) / 3
+	s.w.push(typ)
+	if !s.w.full() {
+		return Value{Time: t.Time, Value: math.NaN()}
+	}
+	dev := s.w.avedev()
+	if dev == 0 {
+		return Value{Time: t.Time, Value: 0}
+	}
+	return Value{Time: t.Time, Value: (typ - s.w.mean()) / (0.015 * dev)}
+}
+
+// CCI 计算n周期的CCI指标
+func CCI(ticks []tushare.DailyTick, n int) []Value {
+	return collect(NewCCIStream(n), ticks)
+}
@@ -0,0 +1,119 @@
+// Package indicator 基于日线数据计算常用技术指标，计算方式与通达信、同花顺等国内行情软件一致
+//
+// 每个指标提供流式计算的类型(通过Next逐条输入数据)及批量计算的函数，
+// 批量计算的结果与输入的数据一一对应，数据不足时值为NaN
+package indicator
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/lwch/tushare"
+)
+
+// Value 单值指标
+type Value struct {
+	Time  time.Time
+	Value float64
+}
+
+type stream[T any] interface {
+	Next(tushare.DailyTick) T
+}
+
+// collect 将数据逐条输入流式指标并收集结果
+func collect[T any](s stream[T], ticks []tushare.DailyTick) []T {
+	ret := make([]T, len(ticks))
+	for i, t := range ticks {
+		ret[i] = s.Next(t)
+	}
+	return ret
+}
+
+// window 固定长度的滑动窗口
+type window struct {
+	buf  []float64
+	pos  int
+	size int
+	sum  float64
+}
+
+func newWindow(n int) *window {
+	return &window{buf: make([]float64, max(n, 1))}
+}
+
+func (w *window) push(v float64) {
+	if w.size == len(w.buf) {
+		w.sum -= w.buf[w.pos]
+	} else {
+		w.size++
+	}
+	w.buf[w.pos] = v
+	w.sum += v
+	w.pos = (w.pos + 1) % len(w.buf)
+}
+
+func (w *window) full() bool {
+	return w.size == len(w.buf)
+}
+
+func (w *window) mean() float64 {
+	return w.sum / float64(w.size)
+}
+
+// std 样本标准差，对应通达信的STD函数
+func (w *window) std() float64 {
+	if w.size < 2 {
+		return 0
+	}
+	mean := w.mean()
+	var sum float64
+	for _, v := range w.buf[:w.size] {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum / float64(w.size-1))
+}
+
+// avedev 平均绝对偏差，对应通达信的AVEDEV函数
+func (w *window) avedev() float64 {
+	mean := w.mean()
+	var sum float64
+	for _, v := range w.buf[:w.size] {
+		sum += math.Abs(v - mean)
+	}
+	return sum / float64(w.size)
+}
+
+func (w *window) min() float64 {
+	return slices.Min(w.buf[:w.size])
+}
+
+func (w *window) max() float64 {
+	return slices.Max(w.buf[:w.size])
+}
+
+// sma 通达信的SMA(X,N,M)，Y=(M*X+(N-M)*Y')/N，首个值为X
+type sma struct {
+	n, m  float64
+	value float64
+	ok    bool
+}
+
+func newSMA(n, m int) *sma {
+	return &sma{n: float64(n), m: float64(m)}
+}
+
+// newEMA 通达信的EMA(X,N)，等价于SMA(X,N+1,2)
+func newEMA(n int) *sma {
+	return newSMA(n+1, 2)
+}
+
+func (s *sma) next(x float64) float64 {
+	if !s.ok {
+		s.value, s.ok = x, true
+		return x
+	}
+	s.value = (s.m*x + (s.n-s.m)*s.value) / s.n
+	return s.value
+}
@@ -0,0 +1,177 @@
+package indicator
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+)
+
+// fixture 固定的5条日线数据，期望值按通达信公式逐条计算
+func fixture() []tushare.DailyTick {
+	high := []float64{10.5, 11.2, 11.0, 11.8, 12.0}
+	low := []float64{9.8, 10.4, 10.2, 10.9, 11.3}
+	close := []float64{10.0, 11.0, 10.5, 11.5, 11.8}
+	vol := []float64{100, 200, 150, 300, 250}
+	ticks := make([]tushare.DailyTick, len(close))
+	for i := range ticks {
+		ticks[i].Code = "000001.SZ"
+		ticks[i].Time = time.Date(2024, 1, 2+i, 0, 0, 0, 0, tushare.Shanghai)
+		ticks[i].High, ticks[i].Low, ticks[i].Close = high[i], low[i], close[i]
+		ticks[i].Volume = vol[i]
+	}
+	return ticks
+}
+
+// series 生成较长的确定性数据，用于比较批量与流式计算的结果
+func series(n int) []tushare.DailyTick {
+	ticks := make([]tushare.DailyTick, n)
+	for i := range ticks {
+		c := 10 + math.Sin(float64(i)/5)*2 + float64(i%7)/10
+		ticks[i].Time = time.Date(2024, 1, 1, 0, 0, 0, 0, tushare.Shanghai).AddDate(0, 0, i)
+		ticks[i].Open, ticks[i].High, ticks[i].Low, ticks[i].Close = c-0.1, c+0.3, c-0.4, c
+		ticks[i].Volume = float64(100 + i%11*10)
+	}
+	return ticks
+}
+
+func near(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) < 1e-4
+}
+
+func checkValues(t *testing.T, name string, got []Value, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d values, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if !near(got[i].Value, want[i]) {
+			t.Errorf("%s[%d] = %v, want %v", name, i, got[i].Value, want[i])
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	ticks := fixture()
+	nan := math.NaN()
+	checkValues(t, "MA3", MA(ticks, 3), []float64{nan, nan, 10.5, 11, 11.2667})
+	checkValues(t, "EMA3", EMA(ticks, 3), []float64{10, 10.5, 10.5, 11, 11.4})
+	// RSI使用SMA(X,N,1)平滑
+	checkValues(t, "RSI3", RSI(ticks, 3), []float64{nan, 100, 80, 87.5, 89.3048})
+	checkValues(t, "ATR3", ATR(ticks, 3), []float64{nan, nan, 0.9, 1.1, 0.9333})
+	checkValues(t, "OBV", OBV(ticks), []float64{0, 200, 50, 350, 600})
+	// CCI使用平均绝对偏差
+	checkValues(t, "CCI3", CCI(ticks, 3), []float64{nan, nan, 13.5135, 100, 72.8814})
+
+	// MACD柱为(DIF-DEA)*2
+	macd := MACD(ticks, 2, 4, 2)
+	wantMACD := [][3]float64{
+		{0, 0, 0},
+		{0.2667, 0.1778, 0.1778},
+		{0.1156, 0.1363, -0.0415},
+		{0.3212, 0.2596, 0.1233},
+		{0.3567, 0.3243, 0.0647},
+	}
+	for i, w := range wantMACD {
+		if v := macd[i]; !near(v.DIF, w[0]) || !near(v.DEA, w[1]) || !near(v.MACD, w[2]) {
+			t.Errorf("MACD[%d] = %+v, want %v", i, v, w)
+		}
+	}
+
+	// K、D以50为初始值并使用SMA(X,N,1)平滑
+	kdj := KDJ(ticks, 3, 3, 3)
+	wantKDJ := [][3]float64{
+		{42.8571, 47.6190, 33.3333},
+		{57.1429, 50.7937, 69.8413},
+		{54.7619, 52.1164, 60.0529},
+		{63.5913, 55.9414, 78.8911},
+		{72.0238, 61.3022, 93.4671},
+	}
+	for i, w := range wantKDJ {
+		if v := kdj[i]; !near(v.K, w[0]) || !near(v.D, w[1]) || !near(v.J, w[2]) {
+			t.Errorf("KDJ[%d] = %+v, want %v", i, v, w)
+		}
+	}
+
+	// BOLL使用样本标准差
+	boll := BOLL(ticks, 3, 2)
+	wantBOLL := [][3]float64{
+		{nan, nan, nan},
+		{nan, nan, nan},
+		{10.5, 11.5, 9.5},
+		{11, 12, 10},
+		{11.2667, 12.6280, 9.9053},
+	}
+	for i, w := range wantBOLL {
+		if v := boll[i]; !near(v.Mid, w[0]) || !near(v.Upper, w[1]) || !near(v.Lower, w[2]) {
+			t.Errorf("BOLL[%d] = %+v, want %v", i, v, w)
+		}
+	}
+}
+
+func TestKDJFlat(t *testing.T) {
+	ticks := fixture()
+	for i := range ticks {
+		ticks[i].High, ticks[i].Low, ticks[i].Close = 10, 10, 10
+	}
+	for i, v := range KDJ(ticks, 9, 3, 3) {
+		if v.K != 50 || v.D != 50 || v.J != 50 {
+			t.Errorf("KDJ[%d] = %+v, want 50", i, v)
+		}
+	}
+}
+
+// streamEqual 逐条调用Next的结果应与批量计算的结果一致
+func streamEqual[T any](t *testing.T, name string, s stream[T], batch []T, ticks []tushare.DailyTick) {
+	t.Helper()
+	for i, tick := range ticks {
+		got := s.Next(tick)
+		if !reflect.DeepEqual(got, batch[i]) && !nanEqual(got, batch[i]) {
+			t.Fatalf("%s[%d]: stream = %+v, batch = %+v", name, i, got, batch[i])
+		}
+	}
+}
+
+// nanEqual 比较包含NaN的结构体，NaN与NaN视为相等
+func nanEqual(a, b any) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	for i := range va.NumField() {
+		fa, fb := va.Field(i), vb.Field(i)
+		if fa.Kind() == reflect.Float64 {
+			if x, y := fa.Float(), fb.Float(); x != y && !(math.IsNaN(x) && math.IsNaN(y)) {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(fa.Interface(), fb.Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBatchMatchesStream(t *testing.T) {
+	ticks := series(120)
+	streamEqual(t, "MA", NewMAStream(20), MA(ticks, 20), ticks)
+	streamEqual(t, "EMA", NewEMAStream(12), EMA(ticks, 12), ticks)
+	streamEqual(t, "MACD", NewMACDStream(12, 26, 9), MACD(ticks, 12, 26, 9), ticks)
+	streamEqual(t, "RSI", NewRSIStream(6), RSI(ticks, 6), ticks)
+	streamEqual(t, "KDJ", NewKDJStream(9, 3, 3), KDJ(ticks, 9, 3, 3), ticks)
+	streamEqual(t, "BOLL", NewBOLLStream(20, 2), BOLL(ticks, 20, 2), ticks)
+	streamEqual(t, "ATR", NewATRStream(14), ATR(ticks, 14), ticks)
+	streamEqual(t, "OBV", NewOBVStream(), OBV(ticks), ticks)
+	streamEqual(t, "CCI", NewCCIStream(14), CCI(ticks, 14), ticks)
+
+	// 批量计算前缀数据的结果应与完整数据的对应部分一致
+	full := MACD(ticks, 12, 26, 9)
+	for i, v := range MACD(ticks[:60], 12, 26, 9) {
+		if v != full[i] {
+			t.Fatalf("MACD prefix[%d] = %+v, want %+v", i, v, full[i])
+		}
+	}
+}
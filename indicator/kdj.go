@@ -0,0 +1,61 @@
+package indicator
+
+import (
+	"time"
+
+	"github.com/lwch/tushare"
+)
+
+// KDJValue KDJ指标
+type KDJValue struct {
+	Time time.Time
+	K    float64
+	D    float64
+	J    float64
+}
+
+// KDJStream 随机指标，常用参数为9,3,3
+//
+//	RSV:=(CLOSE-LLV(LOW,N))/(HHV(HIGH,N)-LLV(LOW,N))*100
+//	K:SMA(RSV,M1,1)
+//	D:SMA(K,M2,1)
+//	J:3*K-2*D
+//
+// K、D以50为初始值，数据不足n条时使用已有数据计算最高最低价，
+// 最高价等于最低价时RSV取50
+type KDJStream struct {
+	high, low *window
+	k, d      *sma
+}
+
+// NewKDJStream 创建KDJ指标
+func NewKDJStream(n, m1, m2 int) *KDJStream {
+	s := &KDJStream{
+		high: newWindow(n),
+		low:  newWindow(n),
+		k:    newSMA(m1, 1),
+		d:    newSMA(m2, 1),
+	}
+	s.k.next(50)
+	s.d.next(50)
+	return s
+}
+
+// Next 输入一条数据
+func (s *KDJStream) Next(t tushare.DailyTick) KDJValue {
+	s.high.push(t.High)
+	s.low.push(t.Low)
+	hhv, llv := s.high.max(), s.low.min()
+	rsv := 50.0
+	if hhv != llv {
+		rsv = (t.Close - llv) / (hhv - llv) * 100
+	}
+	k := s.k.next(rsv)
+	d := s.d.next(k)
+	return KDJValue{Time: t.Time, K: k, D: d, J: 3*k - 2*d}
+}
+
+// KDJ 计算KDJ指标
+func KDJ(ticks []tushare.DailyTick, n, m1, m2 int) []KDJValue {
+	return collect(NewKDJStream(n, m1, m2), ticks)
+}
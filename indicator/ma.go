@@ -0,0 +1,51 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/lwch/tushare"
+)
+
+// MAStream 收盘价的简单移动平均，MA(CLOSE,N)
+type MAStream struct {
+	w *window
+}
+
+// NewMAStream 创建n周期的简单移动平均
+func NewMAStream(n int) *MAStream {
+	return &MAStream{w: newWindow(n)}
+}
+
+// Next 输入一条数据，数据不足n条时返回NaN
+func (s *MAStream) Next(t tushare.DailyTick) Value {
+	s.w.push(t.Close)
+	if !s.w.full() {
+		return Value{Time: t.Time, Value: math.NaN()}
+	}
+	return Value{Time: t.Time, Value: s.w.mean()}
+}
+
+// MA 计算收盘价的n周期简单移动平均
+func MA(ticks []tushare.DailyTick, n int) []Value {
+	return collect(NewMAStream(n), ticks)
+}
+
+// EMAStream 收盘价的指数移动平均，EMA(CLOSE,N)，以首个收盘价为初始值
+type EMAStream struct {
+	ema *sma
+}
+
+// NewEMAStream 创建n周期的指数移动平均
+func NewEMAStream(n int) *EMAStream {
+	return &EMAStream{ema: newEMA(n)}
+}
+
+// Next 输入一条数据
+func (s *EMAStream) Next(t tushare.DailyTick) Value {
+	return Value{Time: t.Time, Value: s.ema.next(t.Close)}
+}
+
+// EMA 计算收盘价的n周期指数移动平均
+func EMA(ticks []tushare.DailyTick, n int) []Value {
+	return collect(NewEMAStream(n), ticks)
+}
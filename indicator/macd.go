@@ -0,0 +1,41 @@
+package indicator
+
+import (
+	"time"
+
+	"github.com/lwch/tushare"
+)
+
+// MACDValue MACD指标
+type MACDValue struct {
+	Time time.Time
+	DIF  float64 // EMA(CLOSE,SHORT)-EMA(CLOSE,LONG)
+	DEA  float64 // EMA(DIF,MID)
+	MACD float64 // (DIF-DEA)*2
+}
+
+// MACDStream MACD指标，常用参数为12,26,9
+type MACDStream struct {
+	short, long, dea *sma
+}
+
+// NewMACDStream 创建MACD指标
+func NewMACDStream(short, long, mid int) *MACDStream {
+	return &MACDStream{
+		short: newEMA(short),
+		long:  newEMA(long),
+		dea:   newEMA(mid),
+	}
+}
+
+// Next 输入一条数据
+func (s *MACDStream) Next(t tushare.DailyTick) MACDValue {
+	dif := s.short.next(t.Close) - s.long.next(t.Close)
+	dea := s.dea.next(dif)
+	return MACDValue{Time: t.Time, DIF: dif, DEA: dea, MACD: (dif - dea) * 2}
+}
+
+// MACD 计算MACD指标
+func MACD(ticks []tushare.DailyTick, short, long, mid int) []MACDValue {
+	return collect(NewMACDStream(short, long, mid), ticks)
+}
@@ -0,0 +1,36 @@
+package indicator
+
+import "github.com/lwch/tushare"
+
+// OBVStream 能量潮指标，首条数据为0
+//
+//	OBV:SUM(IF(CLOSE>REF(CLOSE,1),VOL,IF(CLOSE<REF(CLOSE,1),-VOL,0)),0)
+type OBVStream struct {
+	obv     float64
+	prev    float64
+	started bool
+}
+
+// NewOBVStream 创建OBV指标
+func NewOBVStream() *OBVStream {
+	return &OBVStream{}
+}
+
+// Next 输入一条数据
+func (s *OBVStream) Next(t tushare.DailyTick) Value {
+	if s.started {
+		switch {
+		case t.Close > s.prev:
+			s.obv += t.Volume
+		case t.Close < s.prev:
+			s.obv -= t.Volume
+		}
+	}
+	s.prev, s.started = t.Close, true
+	return Value{Time: t.Time, Value: s.obv}
+}
+
+// OBV 计算OBV指标
+func OBV(ticks []tushare.DailyTick) []Value {
+	return collect(NewOBVStream(), ticks)
+}
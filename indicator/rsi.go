@@ -0,0 +1,43 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/lwch/tushare"
+)
+
+// RSIStream 相对强弱指标，常用参数为6,12,24
+//
+//	LC:=REF(CLOSE,1)
+//	RSI:SMA(MAX(CLOSE-LC,0),N,1)/SMA(ABS(CLOSE-LC),N,1)*100
+type RSIStream struct {
+	up, all *sma
+	prev    float64
+	started bool
+}
+
+// NewRSIStream 创建n周期的RSI指标
+func NewRSIStream(n int) *RSIStream {
+	return &RSIStream{up: newSMA(n, 1), all: newSMA(n, 1)}
+}
+
+// Next 输入一条数据，首条数据返回NaN
+func (s *RSIStream) Next(t tushare.DailyTick) Value {
+	defer func() { s.prev = t.Close }()
+	if !s.started {
+		s.started = true
+		return Value{Time: t.Time, Value: math.NaN()}
+	}
+	diff := t.Close - s.prev
+	up := s.up.next(max(diff, 0))
+	all := s.all.next(math.Abs(diff))
+	if all == 0 {
+		return Value{Time: t.Time, Value: math.NaN()}
+	}
+	return Value{Time: t.Time, Value: up / all * 100}
+}
+
+// RSI 计算n周期的RSI指标
+func RSI(ticks []tushare.DailyTick, n int) []Value {
+	return collect(NewRSIStream(n), ticks)
+}
@@ -0,0 +1,83 @@
+package tushare
+
+import (
+	"context"
+	"time"
+)
+
+// limiter 按固定间隔发放令牌的限流器，相当于容量为1的令牌桶，不允许突发请求：
+// 容量更大时任意一分钟内的请求次数可能超过配额，调用方按请求顺序依次获得令牌
+type limiter struct {
+	interval time.Duration
+	next     time.Time // 下一个令牌的发放时间，由Client.limitMu保护
+}
+
+func newLimiter(perMinute int) *limiter {
+	return &limiter{interval: time.Minute / time.Duration(perMinute)}
+}
+
+// WithRateLimit 设置所有接口合计的每分钟最大请求次数，请求按固定间隔发送，不允许突发
+func WithRateLimit(perMinute int) clientOpt {
+	return func(cli *Client) {
+		if perMinute <= 0 {
+			cli.limit = nil
+			return
+		}
+		cli.limit = newLimiter(perMinute)
+	}
+}
+
+// WithAPIRateLimit 设置指定接口的每分钟最大请求次数，
+// 例如WithAPIRateLimit("daily", 500)
+func WithAPIRateLimit(api string, perMinute int) clientOpt {
+	return func(cli *Client) {
+		if perMinute <= 0 {
+			delete(cli.apiLimits, api)
+			return
+		}
+		if cli.apiLimits == nil {
+			cli.apiLimits = make(map[string]*limiter)
+		}
+		cli.apiLimits[api] = newLimiter(perMinute)
+	}
+}
+
+// wait 阻塞直到接口及全局的限流器都发放令牌或ctx取消，
+// 发送时间取两者中较晚的一个，并以此推进两个限流器
+func (cli *Client) wait(ctx context.Context, api string) error {
+	var limits []*limiter
+	if l, ok := cli.apiLimits[api]; ok {
+		limits = append(limits, l)
+	}
+	if cli.limit != nil {
+		limits = append(limits, cli.limit)
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+	cli.limitMu.Lock()
+	at := time.Now()
+	for _, l := range limits {
+		if l.next.After(at) {
+			at = l.next
+		}
+	}
+	prev := make([]time.Time, len(limits))
+	for i, l := range limits {
+		prev[i] = l.next
+		l.next = at.Add(l.interval)
+	}
+	cli.limitMu.Unlock()
+	err := sleep(ctx, time.Until(at))
+	if err != nil {
+		cli.limitMu.Lock()
+		// 没有后续的调用方排队时归还令牌
+		for i, l := range limits {
+			if l.next.Equal(at.Add(l.interval)) {
+				l.next = prev[i]
+			}
+		}
+		cli.limitMu.Unlock()
+	}
+	return err
+}
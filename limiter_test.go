@@ -0,0 +1,81 @@
+package tushare
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLimiterAPIAndGlobal(t *testing.T) {
+	// 全局每10ms一次，daily每100ms一次
+	cli := New("", WithRateLimit(6000), WithAPIRateLimit("daily", 600))
+	var mu sync.Mutex
+	sent := make(map[string][]time.Time)
+	var wg sync.WaitGroup
+	for i := range 25 {
+		api := "other"
+		if i%5 == 0 {
+			api = "daily"
+		}
+		wg.Go(func() {
+			if err := cli.wait(context.Background(), api); err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			sent[api] = append(sent[api], time.Now())
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	check := func(api string, n int, interval time.Duration) {
+		times := sent[api]
+		if len(times) != n {
+			t.Fatalf("%s: sent %d, want %d", api, len(times), n)
+		}
+		slices.SortFunc(times, time.Time.Compare)
+		for i := 1; i < len(times); i++ {
+			// 允许计时器唤醒的误差
+			if d := times[i].Sub(times[i-1]); d < interval*8/10 {
+				t.Errorf("%s: calls %d and %d sent %v apart, want %v", api, i-1, i, d, interval)
+			}
+		}
+	}
+	check("daily", 5, 100*time.Millisecond)
+	all := append(slices.Clone(sent["daily"]), sent["other"]...)
+	sent["all"] = all
+	check("all", 25, 10*time.Millisecond)
+}
+
+func TestLimiterCancelReturnsToken(t *testing.T) {
+	cli := New("", WithAPIRateLimit("daily", 60))
+	if err := cli.wait(context.Background(), "daily"); err != nil {
+		t.Fatal(err)
+	}
+	next := cli.apiLimits["daily"].next
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := cli.wait(ctx, "daily"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if got := cli.apiLimits["daily"].next; !got.Equal(next) {
+		t.Fatalf("next = %v, want %v", got, next)
+	}
+}
+
+func TestLimiterNone(t *testing.T) {
+	cli := New("")
+	begin := time.Now()
+	for range 100 {
+		if err := cli.wait(context.Background(), "daily"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(begin); d > time.Second {
+		t.Fatalf("took %v without a limit", d)
+	}
+}
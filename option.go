@@ -0,0 +1,91 @@
+package tushare
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithBaseURL 设置接口地址，默认为DefaultBaseURL
+func WithBaseURL(baseURL string) clientOpt {
+	return func(cli *Client) {
+		cli.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient 使用自定义的http.Client，未设置WithTimeout时使用其自身的Timeout，
+// 同时设置WithTimeout或WithProxy时不会修改传入的http.Client，而是使用其副本，与选项的顺序无关
+func WithHTTPClient(hc *http.Client) clientOpt {
+	return func(cli *Client) {
+		cli.cli = hc
+	}
+}
+
+// WithTimeout 设置单次请求的超时时间，为0时不超时，
+// 默认为10秒，使用WithHTTPClient时默认使用其自身的Timeout
+func WithTimeout(timeout time.Duration) clientOpt {
+	return func(cli *Client) {
+		cli.timeout = &timeout
+	}
+}
+
+// WithProxy 设置HTTP代理，http.Client的Transport须为空或*http.Transport，
+// 否则无法设置代理，所有请求都将返回错误
+func WithProxy(proxy *url.URL) clientOpt {
+	return func(cli *Client) {
+		cli.proxy = proxy
+	}
+}
+
+// WithUserAgent 设置请求的User-Agent
+func WithUserAgent(userAgent string) clientOpt {
+	return func(cli *Client) {
+		cli.userAgent = userAgent
+	}
+}
+
+// WithStrictDecode 严格解析接口返回的数据，
+// 列不存在或值无法转换时返回*DecodeError，默认忽略此类错误并使用零值
+func WithStrictDecode() clientOpt {
+	return func(cli *Client) {
+		cli.strict = true
+	}
+}
+
+// WithLocation 设置解析及格式化日期时使用的时区，默认为Shanghai
+func WithLocation(loc *time.Location) clientOpt {
+	return func(cli *Client) {
+		if loc == nil {
+			loc = Shanghai
+		}
+		cli.loc = loc
+	}
+}
+
+// httpClient 将WithTimeout及WithProxy应用到http.Client上
+func (cli *Client) httpClient() (*http.Client, error) {
+	if cli.cli == nil {
+		cli.cli = &http.Client{Timeout: defaultTimeout}
+	} else if cli.timeout == nil && cli.proxy == nil {
+		return cli.cli, nil
+	}
+	hc := *cli.cli
+	if cli.timeout != nil {
+		hc.Timeout = *cli.timeout
+	}
+	if cli.proxy != nil {
+		var tr *http.Transport
+		switch t := hc.Transport.(type) {
+		case nil:
+			tr = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			tr = t.Clone()
+		default:
+			return nil, fmt.Errorf("tushare: WithProxy requires *http.Transport, got %T", t)
+		}
+		tr.Proxy = http.ProxyURL(cli.proxy)
+		hc.Transport = tr
+	}
+	return &hc, nil
+}
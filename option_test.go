@@ -0,0 +1,65 @@
+package tushare
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHTTPClientOptionOrder(t *testing.T) {
+	hc := &http.Client{Timeout: time.Minute}
+	tests := []struct {
+		name string
+		opts []clientOpt
+		want time.Duration
+	}{
+		{"default", nil, defaultTimeout},
+		{"http client", []clientOpt{WithHTTPClient(hc)}, time.Minute},
+		{"timeout first", []clientOpt{WithTimeout(5 * time.Second), WithHTTPClient(hc)}, 5 * time.Second},
+		{"timeout last", []clientOpt{WithHTTPClient(hc), WithTimeout(5 * time.Second)}, 5 * time.Second},
+		{"no timeout", []clientOpt{WithTimeout(0)}, 0},
+	}
+	for _, tt := range tests {
+		cli := New("token", tt.opts...)
+		if cli.cli.Timeout != tt.want {
+			t.Errorf("%s: timeout = %v, want %v", tt.name, cli.cli.Timeout, tt.want)
+		}
+	}
+	if hc.Timeout != time.Minute {
+		t.Errorf("WithHTTPClient modified the given client: timeout = %v", hc.Timeout)
+	}
+}
+
+func TestProxyUnsupportedTransport(t *testing.T) {
+	proxy, _ := url.Parse("http://127.0.0.1:8080")
+	var calls int
+	hc := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+		return nil, errors.New("unreachable")
+	})}
+	cli := New("token", WithProxy(proxy), WithHTTPClient(hc))
+	_, _, err := cli.Call("daily", nil, nil)
+	if err == nil {
+		t.Fatal("expected error for proxy with custom transport")
+	}
+	if calls != 0 {
+		t.Fatalf("transport called %d times", calls)
+	}
+
+	cli = New("token", WithHTTPClient(&http.Client{}), WithProxy(proxy))
+	if cli.err != nil {
+		t.Fatal(cli.err)
+	}
+	tr, ok := cli.cli.Transport.(*http.Transport)
+	if !ok || tr.Proxy == nil {
+		t.Fatal("proxy not applied to default transport")
+	}
+}
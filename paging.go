@@ -0,0 +1,132 @@
+package tushare
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"strconv"
+)
+
+// CallAll 调用接口并自动翻页获取全部数据
+func (cli *Client) CallAll(api string, args Args, fields []string) ([]string, [][]any, error) {
+	return cli.CallAllContext(context.Background(), api, args, fields)
+}
+
+// CallAllContext 调用接口并自动翻页获取全部数据，
+// 当接口返回has_more时使用offset参数继续获取下一页，
+// args中的limit参数表示最多获取的总行数，offset参数表示起始行
+func (cli *Client) CallAllContext(ctx context.Context, api string, args Args, fields []string) ([]string, [][]any, error) {
+	var columns []string
+	var items [][]any
+	for p, err := range cli.pages(ctx, api, args, fields) {
+		if err != nil {
+			return nil, nil, err
+		}
+		if columns == nil {
+			columns = p.fields
+		}
+		items = append(items, p.items...)
+	}
+	return columns, items, nil
+}
+
+// pages 逐页获取数据，调用方停止迭代时不再请求后续页
+func (cli *Client) pages(ctx context.Context, api string, args Args, fields []string) iter.Seq2[page, error] {
+	return func(yield func(page, error) bool) {
+		offset := argInt(args, "offset")
+		total := argInt(args, "limit")
+		var got int
+		for {
+			a := maps.Clone(args)
+			if a == nil {
+				a = make(Args)
+			}
+			if offset > 0 {
+				a["offset"] = offset
+			}
+			if total > 0 {
+				a["limit"] = total - got
+			}
+			p, err := cli.callRetry(ctx, api, a, fields)
+			if err != nil {
+				yield(page{}, err)
+				return
+			}
+			if !yield(p, nil) {
+				return
+			}
+			got += len(p.items)
+			offset += len(p.items)
+			if !p.hasMore || len(p.items) == 0 {
+				return
+			}
+			if total > 0 && got >= total {
+				return
+			}
+		}
+	}
+}
+
+// iterRows 逐页获取数据并逐行解析
+func iterRows[T any](ctx context.Context, cli *Client, api string, args Args, fields []string) iter.Seq2[T, error] {
+	fields = selectFields(args, fields)
+	return func(yield func(T, error) bool) {
+		for p, err := range cli.pages(ctx, api, args, fields) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			rows, err := decodeRows[T](cli, api, fields, p.fields, p.items)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// WithLimit 设置最多获取的总行数，可用于所有列表查询接口
+func WithLimit(n int) func(Args) {
+	return func(args Args) {
+		args["limit"] = n
+	}
+}
+
+// WithOffset 设置起始行，可用于所有列表查询接口
+func WithOffset(n int) func(Args) {
+	return func(args Args) {
+		args["offset"] = n
+	}
+}
+
+func argInt(args Args, key string) int {
+	switch v := args[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	return 0
+}
+
+// query 获取全部数据并解析为[]T，fields为默认获取的列
+func query[T any](ctx context.Context, cli *Client, api string, args Args, fields []string) ([]T, error) {
+	fields = selectFields(args, fields)
+	columns, data, err := cli.CallAllContext(ctx, api, args, fields)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRows[T](cli, api, fields, columns, data)
+}
@@ -0,0 +1,302 @@
+// https://tushare.pro/document/2?doc_id=109
+
+package tushare
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type proBarAsset string
+
+const (
+	ProBarAssetStock  proBarAsset = "E"  // 股票
+	ProBarAssetIndex  proBarAsset = "I"  // 指数
+	ProBarAssetFund   proBarAsset = "FD" // 场内基金
+	ProBarAssetFuture proBarAsset = "FT" // 期货
+)
+
+type proBarFreq string
+
+const (
+	ProBarFreqDaily   proBarFreq = "D"     // 日线
+	ProBarFreqWeekly  proBarFreq = "W"     // 周线
+	ProBarFreqMonthly proBarFreq = "M"     // 月线
+	ProBarFreq1Min    proBarFreq = "1min"  // 1分钟
+	ProBarFreq5Min    proBarFreq = "5min"  // 5分钟
+	ProBarFreq15Min   proBarFreq = "15min" // 15分钟
+	ProBarFreq30Min   proBarFreq = "30min" // 30分钟
+	ProBarFreq60Min   proBarFreq = "60min" // 60分钟
+)
+
+func (f proBarFreq) minute() bool {
+	switch f {
+	case ProBarFreq1Min, ProBarFreq5Min, ProBarFreq15Min, ProBarFreq30Min, ProBarFreq60Min:
+		return true
+	}
+	return false
+}
+
+// Bar 通用行情数据
+type Bar struct {
+	DailyTick
+	MA    map[int]float64 // 收盘价均线，key为窗口大小，数据不足时不包含对应的key
+	MAVol map[int]float64 // 成交量均线，key为窗口大小，数据不足时不包含对应的key
+}
+
+type proBarConfig struct {
+	asset      proBarAsset
+	freq       proBarFreq
+	adj        adjustMode
+	ma         []int
+	start, end time.Time
+}
+
+type proBarOpt func(*proBarConfig)
+
+// WithProBarAsset 设置资产类别，默认为股票
+func WithProBarAsset(asset proBarAsset) proBarOpt {
+	return func(cfg *proBarConfig) {
+		cfg.asset = asset
+	}
+}
+
+// WithProBarFreq 设置数据频度，默认为日线
+func WithProBarFreq(freq proBarFreq) proBarOpt {
+	return func(cfg *proBarConfig) {
+		cfg.freq = freq
+	}
+}
+
+// WithProBarAdjust 设置复权方式，仅对股票及场内基金有效，默认不复权，
+// 没有复权因子时ProBar返回ErrNoAdjFactor
+func WithProBarAdjust(mode adjustMode) proBarOpt {
+	return func(cfg *proBarConfig) {
+		cfg.adj = mode
+	}
+}
+
+// WithProBarMA 设置需要计算的均线窗口，例如WithProBarMA(5, 10, 20)
+func WithProBarMA(windows ...int) proBarOpt {
+	return func(cfg *proBarConfig) {
+		cfg.ma = windows
+	}
+}
+
+// WithProBarDateRange 设置日期范围
+func WithProBarDateRange(start, end time.Time) proBarOpt {
+	return func(cfg *proBarConfig) {
+		cfg.start = start
+		cfg.end = end
+	}
+}
+
+// ProBar 通用行情接口，与官方SDK的pro_bar类似，
+// 根据资产类别及频度调用对应的接口，按时间升序返回并计算均线
+func (cli *Client) ProBar(code string, opts ...proBarOpt) ([]Bar, error) {
+	return cli.ProBarContext(context.Background(), code, opts...)
+}
+
+// ProBarContext 通用行情接口，与官方SDK的pro_bar类似，
+// 根据资产类别及频度调用对应的接口，按时间升序返回并计算均线
+func (cli *Client) ProBarContext(ctx context.Context, code string, opts ...proBarOpt) ([]Bar, error) {
+	cfg := proBarConfig{asset: ProBarAssetStock, freq: ProBarFreqDaily}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	ticks, err := cli.proBarTicks(ctx, code, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.adj != AdjustNone && len(ticks) > 0 {
+		var adjAPI string
+		var digits int
+		switch cfg.asset {
+		case ProBarAssetStock:
+			adjAPI, digits = "adj_factor", 2
+		case ProBarAssetFund:
+			adjAPI, digits = "fund_adj", 3
+		}
+		if adjAPI != "" {
+			factors, err := cli.codeFactors(ctx, adjAPI, code, cfg.dateRange)
+			if err != nil {
+				return nil, err
+			}
+			ticks = adjust(ticks, factors, cfg.adj, digits)
+		}
+	}
+	slices.SortFunc(ticks, func(a, b DailyTick) int {
+		return a.Time.Compare(b.Time)
+	})
+	bars := make([]Bar, len(ticks))
+	for i, t := range ticks {
+		bars[i].DailyTick = t
+	}
+	for _, n := range cfg.ma {
+		movingAverage(bars, n)
+	}
+	return bars, nil
+}
+
+func proBarEnd(end time.Time) time.Time {
+	if end.IsZero() {
+		return time.Now()
+	}
+	return end
+}
+
+// dateRange 设置日期范围参数，仅设置了结束日期时不传start_date
+func (cfg proBarConfig) dateRange(args Args) {
+	if !cfg.start.IsZero() {
+		args["start_date"] = cfg.start
+	}
+	if !cfg.start.IsZero() || !cfg.end.IsZero() {
+		args["end_date"] = proBarEnd(cfg.end)
+	}
+}
+
+func (cli *Client) proBarTicks(ctx context.Context, code string, cfg proBarConfig) ([]DailyTick, error) {
+	dateRange := cfg.dateRange
+	if cfg.freq.minute() {
+		var api string
+		switch cfg.asset {
+		case ProBarAssetStock, ProBarAssetFund:
+			api = "stk_mins"
+		case ProBarAssetFuture:
+			api = "ft_mins"
+		}
+		if api != "" {
+			return cli.minutes(ctx, api, code, cfg)
+		}
+	}
+	switch cfg.asset {
+	case ProBarAssetStock:
+		switch cfg.freq {
+		case ProBarFreqDaily:
+			return cli.daily(ctx, "daily", WithDailyCode(code), dateRange)
+		case ProBarFreqWeekly:
+			return cli.WeeklyContext(ctx, WithDailyCode(code), dateRange)
+		case ProBarFreqMonthly:
+			return cli.MonthlyContext(ctx, WithDailyCode(code), dateRange)
+		}
+	case ProBarAssetIndex:
+		switch cfg.freq {
+		case ProBarFreqDaily:
+			return cli.IndexDailyContext(ctx, code, dateRange)
+		case ProBarFreqWeekly:
+			return cli.daily(ctx, "index_weekly", WithDailyCode(code), dateRange)
+		case ProBarFreqMonthly:
+			return cli.IndexMonthlyContext(ctx, code, dateRange)
+		}
+	case ProBarAssetFund:
+		if cfg.freq == ProBarFreqDaily {
+			return cli.daily(ctx, "fund_daily", WithDailyCode(code), dateRange)
+		}
+	case ProBarAssetFuture:
+		if cfg.freq == ProBarFreqDaily {
+			return cli.futDaily(ctx, code, dateRange)
+		}
+	}
+	return nil, fmt.Errorf("pro_bar: unsupported asset %s with freq %s", cfg.asset, cfg.freq)
+}
+
+// minutes 获取分钟线数据，api为stk_mins或ft_mins
+func (cli *Client) minutes(ctx context.Context, api, code string, cfg proBarConfig) ([]DailyTick, error) {
+	args := Args{
+		"ts_code": code,
+		"freq":    string(cfg.freq),
+	}
+	if !cfg.start.IsZero() {
+		args["start_date"] = cfg.start.In(cli.loc).Format(time.DateTime)
+	}
+	if !cfg.end.IsZero() {
+		args["end_date"] = cfg.end.In(cli.loc).Format(time.DateTime)
+	}
+	rows, err := query[struct {
+		Columns
+		Code     string    `tushare:"ts_code"`
+		Time     time.Time `tushare:"trade_time,datetime"`
+		Open     float64   `tushare:"open"`
+		High     float64   `tushare:"high"`
+		Low      float64   `tushare:"low"`
+		Close    float64   `tushare:"close"`
+		Volume   float64   `tushare:"vol"`
+		Turnover float64   `tushare:"amount"`
+	}](ctx, cli, api, args, []string{
+		"ts_code", "trade_time",
+		"open", "high", "low", "close",
+		"vol", "amount"})
+	if err != nil {
+		return nil, err
+	}
+	ticks := make([]DailyTick, len(rows))
+	for i, row := range rows {
+		ticks[i].Tick = Tick(row)
+	}
+	slices.SortFunc(ticks, func(a, b DailyTick) int {
+		return a.Time.Compare(b.Time)
+	})
+	for i := range ticks {
+		if i > 0 {
+			ticks[i].PreClose = ticks[i-1].Close
+		}
+		ticks[i].recalc()
+	}
+	return ticks, nil
+}
+
+// futDaily 获取期货日线数据，涨跌额及涨跌幅按昨收价计算
+func (cli *Client) futDaily(ctx context.Context, code string, opts ...func(Args)) ([]DailyTick, error) {
+	args := Args{"ts_code": code}
+	for _, o := range opts {
+		o(args)
+	}
+	ticks, err := query[DailyTick](ctx, cli, "fut_daily", args, []string{
+		"ts_code", "trade_date",
+		"open", "high", "low", "close",
+		"pre_close", "vol", "amount"})
+	if err != nil {
+		return nil, err
+	}
+	for i := range ticks {
+		ticks[i].recalc()
+	}
+	return ticks, nil
+}
+
+// recalc 根据收盘价及昨收价计算涨跌额及涨跌幅
+func (t *DailyTick) recalc() {
+	if t.PreClose == 0 {
+		t.Change, t.PctChg = 0, 0
+		return
+	}
+	t.Change = t.Close - t.PreClose
+	t.PctChg = t.Change / t.PreClose * 100
+}
+
+// movingAverage 计算收盘价及成交量的n周期简单移动平均
+func movingAverage(bars []Bar, n int) {
+	if n <= 0 {
+		return
+	}
+	var sum, sumVol float64
+	for i := range bars {
+		sum += bars[i].Close
+		sumVol += bars[i].Volume
+		if i >= n {
+			sum -= bars[i-n].Close
+			sumVol -= bars[i-n].Volume
+		}
+		if i < n-1 {
+			continue
+		}
+		if bars[i].MA == nil {
+			bars[i].MA = make(map[int]float64)
+			bars[i].MAVol = make(map[int]float64)
+		}
+		bars[i].MA[n] = sum / float64(n)
+		bars[i].MAVol[n] = sumVol / float64(n)
+	}
+}
@@ -0,0 +1,308 @@
+package tushare_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+// barTick 测试用的日线数据
+func barTick(code string, day int, close, vol float64) tushare.DailyTick {
+	var t tushare.DailyTick
+	t.Code, t.Time = code, date(2024, 1, day)
+	t.Open, t.High, t.Low, t.Close = close, close, close, close
+	t.PreClose, t.Volume, t.Turnover = close-0.1, vol, vol*close
+	return t
+}
+
+func TestAdjustedNoFactors(t *testing.T) {
+	srv := tusharetest.NewServer()
+	defer srv.Close()
+	srv.LoadDaily("daily", []tushare.DailyTick{barTick("000001.SZ", 2, 9.39, 100)})
+	srv.LoadDaily("fund_daily", []tushare.DailyTick{barTick("510300.SH", 2, 3.5, 100)})
+	srv.LoadAdjFactor("adj_factor", []tushare.Adjust{{Code: "000002.SZ", Date: date(2024, 1, 2), Factor: 1.5}})
+	srv.LoadAdjFactor("fund_adj", nil)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL))
+
+	for _, mode := range []string{"qfq", "hfq"} {
+		adj := tushare.AdjustForward
+		if mode == "hfq" {
+			adj = tushare.AdjustBackward
+		}
+		if _, err := cli.AdjustedDaily("000001.SZ", date(2024, 1, 1), date(2024, 1, 31), adj); !errors.Is(err, tushare.ErrNoAdjFactor) {
+			t.Errorf("AdjustedDaily %s: err = %v, want ErrNoAdjFactor", mode, err)
+		}
+		if _, err := cli.ProBar("000001.SZ", tushare.WithProBarAdjust(adj)); !errors.Is(err, tushare.ErrNoAdjFactor) {
+			t.Errorf("ProBar %s: err = %v, want ErrNoAdjFactor", mode, err)
+		}
+		if _, err := cli.ProBar("510300.SH", tushare.WithProBarAsset(tushare.ProBarAssetFund),
+			tushare.WithProBarAdjust(adj)); !errors.Is(err, tushare.ErrNoAdjFactor) {
+			t.Errorf("ProBar fund %s: err = %v, want ErrNoAdjFactor", mode, err)
+		}
+	}
+
+	// 不复权时不需要复权因子
+	ticks, err := cli.AdjustedDaily("000001.SZ", date(2024, 1, 1), date(2024, 1, 31), tushare.AdjustNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 1 || ticks[0].Close != 9.39 {
+		t.Fatalf("unexpected rows %+v", ticks)
+	}
+	// 没有行情数据时返回空结果
+	ticks, err = cli.AdjustedDaily("000002.SZ", date(2024, 1, 1), date(2024, 1, 31), tushare.AdjustForward)
+	if err != nil || len(ticks) != 0 {
+		t.Fatalf("got %v, %v, want no rows", ticks, err)
+	}
+}
+
+// paramRecorder 记录每个接口最后一次请求的参数
+type paramRecorder struct {
+	mu     sync.Mutex
+	params map[string]map[string]any
+}
+
+func (r *paramRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	var v struct {
+		API    string         `json:"api_name"`
+		Params map[string]any `json:"params"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.params[v.API] = v.Params
+	r.mu.Unlock()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (r *paramRecorder) get(api string) map[string]any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.params[api]
+}
+
+func proBarServer(t *testing.T) (*tusharetest.Server, *tushare.Client, *paramRecorder) {
+	t.Helper()
+	srv := tusharetest.NewServer()
+	t.Cleanup(srv.Close)
+	var ticks []tushare.DailyTick
+	for i, d := range []int{2, 3, 4, 5, 8} {
+		ticks = append(ticks, barTick("000001.SZ", d, float64(10+i), float64(100*(i+1))))
+	}
+	srv.LoadDaily("daily", ticks)
+	srv.LoadDaily("weekly", []tushare.DailyTick{barTick("000001.SZ", 5, 20, 1000)})
+	srv.LoadDaily("monthly", []tushare.DailyTick{barTick("000001.SZ", 31, 30, 5000)})
+	srv.LoadDaily("index_daily", []tushare.DailyTick{barTick("000300.SH", 2, 3400, 100)})
+	srv.LoadDaily("index_weekly", []tushare.DailyTick{barTick("000300.SH", 5, 3450, 500)})
+	srv.LoadDaily("index_monthly", []tushare.DailyTick{barTick("000300.SH", 31, 3500, 2000)})
+	srv.LoadDaily("fund_daily", []tushare.DailyTick{barTick("510300.SH", 2, 3.512, 100)})
+	fut := barTick("IF2401.CFX", 2, 3500, 10)
+	fut.PreClose = 3400
+	srv.LoadDaily("fut_daily", []tushare.DailyTick{fut})
+	srv.AddRows("stk_mins", []string{"ts_code", "trade_time", "open", "high", "low", "close", "vol", "amount"}, [][]any{
+		{"000001.SZ", "2024-01-02 09:32:00", 9.4, 9.42, 9.39, 9.41, 2000., 18820.},
+		{"000001.SZ", "2024-01-02 09:31:00", 9.39, 9.4, 9.38, 9.4, 1000., 9400.},
+	})
+	srv.LoadAdjFactor("adj_factor", []tushare.Adjust{
+		{Code: "000001.SZ", Date: date(2024, 1, 2), Factor: 1},
+		{Code: "000001.SZ", Date: date(2024, 1, 4), Factor: 2},
+	})
+	rec := &paramRecorder{params: make(map[string]map[string]any)}
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithHTTPClient(&http.Client{Transport: rec}))
+	return srv, cli, rec
+}
+
+func TestProBarMapping(t *testing.T) {
+	srv, cli, rec := proBarServer(t)
+	tests := []struct {
+		api   string
+		run   func() ([]tushare.Bar, error)
+		close float64
+	}{
+		{"daily", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ")
+		}, 10},
+		{"weekly", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ", tushare.WithProBarFreq(tushare.ProBarFreqWeekly))
+		}, 20},
+		{"monthly", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ", tushare.WithProBarFreq(tushare.ProBarFreqMonthly))
+		}, 30},
+		{"index_daily", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000300.SH", tushare.WithProBarAsset(tushare.ProBarAssetIndex))
+		}, 3400},
+		{"index_weekly", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000300.SH", tushare.WithProBarAsset(tushare.ProBarAssetIndex),
+				tushare.WithProBarFreq(tushare.ProBarFreqWeekly))
+		}, 3450},
+		{"index_monthly", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000300.SH", tushare.WithProBarAsset(tushare.ProBarAssetIndex),
+				tushare.WithProBarFreq(tushare.ProBarFreqMonthly))
+		}, 3500},
+		{"fund_daily", func() ([]tushare.Bar, error) {
+			return cli.ProBar("510300.SH", tushare.WithProBarAsset(tushare.ProBarAssetFund))
+		}, 3.512},
+		{"fut_daily", func() ([]tushare.Bar, error) {
+			return cli.ProBar("IF2401.CFX", tushare.WithProBarAsset(tushare.ProBarAssetFuture))
+		}, 3500},
+		{"stk_mins", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ", tushare.WithProBarFreq(tushare.ProBarFreq1Min))
+		}, 9.4},
+	}
+	for _, tt := range tests {
+		bars, err := tt.run()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.api, err)
+		}
+		if n := srv.Calls(tt.api); n != 1 {
+			t.Errorf("%s: calls = %d, want 1", tt.api, n)
+		}
+		if len(bars) == 0 || bars[0].Close != tt.close {
+			t.Errorf("%s: bars = %+v, want close %v", tt.api, bars, tt.close)
+		}
+	}
+	if n := srv.Calls("adj_factor"); n != 0 {
+		t.Errorf("adj_factor called %d times without WithProBarAdjust", n)
+	}
+
+	// 期货按昨收价计算涨跌幅
+	bars, _ := cli.ProBar("IF2401.CFX", tushare.WithProBarAsset(tushare.ProBarAssetFuture))
+	if bars[0].Change != 100 || math.Abs(bars[0].PctChg-2.9412) > 1e-4 {
+		t.Errorf("future bar = %+v", bars[0].DailyTick)
+	}
+	// 分钟线按时间升序排列，昨收价为上一根K线的收盘价
+	bars, _ = cli.ProBar("000001.SZ", tushare.WithProBarFreq(tushare.ProBarFreq1Min))
+	if len(bars) != 2 || !bars[0].Time.Before(bars[1].Time) || bars[0].PreClose != 0 || bars[1].PreClose != 9.4 {
+		t.Errorf("minute bars = %+v", bars)
+	}
+	if freq := rec.get("stk_mins")["freq"]; freq != "1min" {
+		t.Errorf("stk_mins freq = %v, want 1min", freq)
+	}
+}
+
+func TestProBarAdjust(t *testing.T) {
+	_, cli, _ := proBarServer(t)
+	tests := []struct {
+		name string
+		run  func() ([]tushare.Bar, error)
+		want []float64
+	}{
+		// 前复权除以最新的复权因子2
+		{"qfq", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ", tushare.WithProBarAdjust(tushare.AdjustForward))
+		}, []float64{5, 5.5, 12, 13, 14}},
+		{"hfq", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ", tushare.WithProBarAdjust(tushare.AdjustBackward))
+		}, []float64{10, 11, 24, 26, 28}},
+		{"none", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000001.SZ")
+		}, []float64{10, 11, 12, 13, 14}},
+	}
+	for _, tt := range tests {
+		bars, err := tt.run()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var got []float64
+		for _, b := range bars {
+			got = append(got, b.Close)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: close = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProBarMA(t *testing.T) {
+	_, cli, _ := proBarServer(t)
+	bars, err := cli.ProBar("000001.SZ", tushare.WithProBarMA(3, 5, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, b := range bars {
+		_, ok3 := b.MA[3]
+		_, ok5 := b.MA[5]
+		_, ok10 := b.MA[10]
+		if ok3 != (i >= 2) || ok5 != (i >= 4) || ok10 {
+			t.Errorf("bar %d: MA = %v", i, b.MA)
+		}
+		_, vol3 := b.MAVol[3]
+		if vol3 != ok3 {
+			t.Errorf("bar %d: MAVol = %v", i, b.MAVol)
+		}
+	}
+	if bars[2].MA[3] != 11 || bars[2].MAVol[3] != 200 || bars[4].MA[3] != 13 || bars[4].MA[5] != 12 || bars[4].MAVol[5] != 300 {
+		t.Errorf("MA = %v, MAVol = %v", bars[4].MA, bars[4].MAVol)
+	}
+}
+
+func TestProBarUnsupported(t *testing.T) {
+	_, cli, _ := proBarServer(t)
+	tests := []struct {
+		name string
+		run  func() ([]tushare.Bar, error)
+	}{
+		{"index minutes", func() ([]tushare.Bar, error) {
+			return cli.ProBar("000300.SH", tushare.WithProBarAsset(tushare.ProBarAssetIndex),
+				tushare.WithProBarFreq(tushare.ProBarFreq5Min))
+		}},
+		{"fund weekly", func() ([]tushare.Bar, error) {
+			return cli.ProBar("510300.SH", tushare.WithProBarAsset(tushare.ProBarAssetFund),
+				tushare.WithProBarFreq(tushare.ProBarFreqWeekly))
+		}},
+		{"future monthly", func() ([]tushare.Bar, error) {
+			return cli.ProBar("IF2401.CFX", tushare.WithProBarAsset(tushare.ProBarAssetFuture),
+				tushare.WithProBarFreq(tushare.ProBarFreqMonthly))
+		}},
+	}
+	for _, tt := range tests {
+		if bars, err := tt.run(); err == nil {
+			t.Errorf("%s: got %d bars, want an error", tt.name, len(bars))
+		}
+	}
+}
+
+func TestProBarEndDateOnly(t *testing.T) {
+	_, cli, rec := proBarServer(t)
+	bars, err := cli.ProBar("000001.SZ", tushare.WithProBarAdjust(tushare.AdjustForward),
+		tushare.WithProBarDateRange(time.Time{}, date(2024, 1, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bars) != 4 {
+		t.Fatalf("got %d bars, want 4", len(bars))
+	}
+	for _, api := range []string{"daily", "adj_factor"} {
+		params := rec.get(api)
+		if _, ok := params["start_date"]; ok {
+			t.Errorf("%s: start_date = %v, want unset", api, params["start_date"])
+		}
+		if params["end_date"] != "20240105" {
+			t.Errorf("%s: end_date = %v, want 20240105", api, params["end_date"])
+		}
+	}
+
+	bars, err = cli.ProBar("000001.SZ", tushare.WithProBarDateRange(date(2024, 1, 4), time.Time{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bars) != 3 || rec.get("daily")["start_date"] != "20240104" {
+		t.Fatalf("got %d bars, params %v", len(bars), rec.get("daily"))
+	}
+}
@@ -0,0 +1,194 @@
+package tushare
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Report 财务报表的公共字段
+type Report struct {
+	Code       string         `tushare:"ts_code"`         // 股票代码
+	AnnDate    time.Time      `tushare:"ann_date,date"`   // 公告日期
+	FAnnDate   time.Time      `tushare:"f_ann_date,date"` // 实际公告日期
+	EndDate    time.Time      `tushare:"end_date,date"`   // 报告期
+	ReportType reportType     `tushare:"report_type"`     // 报告类型
+	CompType   reportCompType `tushare:"comp_type"`       // 公司类型
+	EndType    string         `tushare:"end_type"`        // 报告期类型
+	UpdateFlag string         `tushare:"update_flag"`     // 更新标识(0未修改/1更正后的数据)
+}
+
+var reportFields = []string{
+	"ts_code", "ann_date", "f_ann_date", "end_date",
+	"report_type", "comp_type", "end_type", "update_flag",
+}
+
+// reportKeyFields 区分不同报告所需的列，使用WithFields时也会获取
+var reportKeyFields = []string{
+	"ts_code", "ann_date", "f_ann_date", "end_date", "report_type", "update_flag",
+}
+
+func (r Report) report() Report {
+	return r
+}
+
+type reportType string
+
+const (
+	ReportType合并报表     reportType = "1"  // 上市公司最新报表(默认)
+	ReportType单季合并     reportType = "2"  // 单一季度的合并报表
+	ReportType调整单季合并表  reportType = "3"  // 调整后的单季合并报表(如果有)
+	ReportType调整合并报表   reportType = "4"  // 本年度公布上年同期的财务报表数据，报告期为上年度
+	ReportType调整前合并报表  reportType = "5"  // 数据发生变更，将原数据进行保留，即调整前的原数据
+	ReportType母公司报表    reportType = "6"  // 母公司的财务报表数据
+	ReportType母公司单季表   reportType = "7"  // 母公司的单季度表
+	ReportType母公司调整单季表 reportType = "8"  // 母公司调整后的单季表
+	ReportType母公司调整表   reportType = "9"  // 该公司母公司的本年度公布上年同期的财务报表数据
+	ReportType母公司调整前报表 reportType = "10" // 母公司调整之前的原始财务报表数据
+)
+
+type reportCompType string
+
+const (
+	ReportCompType一般工商业 reportCompType = "1"
+	ReportCompType银行    reportCompType = "2"
+	ReportCompType保险    reportCompType = "3"
+	ReportCompType证券    reportCompType = "4"
+)
+
+type reportOpt func(Args)
+
+// report 获取财务报表，并去除已被更正的数据
+func report[T interface{ report() Report }, O ~func(Args)](ctx context.Context, cli *Client, api string, fields []string, opts ...O) ([]T, error) {
+	rows, err := rawReports[T](ctx, cli, api, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return LatestReports(rows), nil
+}
+
+// rawReports 获取未去重的财务报表，
+// 无论是否使用WithFields都会获取fields中用于区分报告的列
+func rawReports[T interface{ report() Report }, O ~func(Args)](ctx context.Context, cli *Client, api string, fields []string, opts ...O) ([]T, error) {
+	args := make(Args)
+	for _, o := range opts {
+		o(args)
+	}
+	var keys []string
+	for _, field := range fields {
+		if slices.Contains(reportKeyFields, field) {
+			keys = append(keys, field)
+		}
+	}
+	WithExtraFields(keys...)(args)
+	return query[T](ctx, cli, api, args, fields)
+}
+
+// LatestReports 按(Code, AnnDate, EndDate, ReportType)去重，
+// 同一份报告存在多条数据时保留update_flag为1(更正后)的数据，否则保留第一条
+func LatestReports[T interface{ report() Report }](rows []T) []T {
+	type key struct {
+		code       string
+		annDate    time.Time
+		endDate    time.Time
+		reportType reportType
+	}
+	index := make(map[key]int, len(rows))
+	ret := make([]T, 0, len(rows))
+	for _, row := range rows {
+		r := row.report()
+		k := key{r.Code, r.AnnDate, r.EndDate, r.ReportType}
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(ret)
+			ret = append(ret, row)
+			continue
+		}
+		if r.UpdateFlag == "1" && ret[i].report().UpdateFlag != "1" {
+			ret[i] = row
+		}
+	}
+	return ret
+}
+
+// ReportsAsOf 返回每家公司在date(含)之前公告的最新一期报告，可用于避免使用未来数据，
+// 有实际公告日期(f_ann_date)时按实际公告日期判断，报告期相同时使用公告日期较晚的数据，
+// 公告日期也相同时使用update_flag为0(公告时)的数据，没有公告日期的数据无法确定可用时间，直接忽略。
+// rows应为未经LatestReports去重的数据，否则date之后才更正的数据会替换公告时的数据
+func ReportsAsOf[T interface{ report() Report }](rows []T, date time.Time) []T {
+	index := make(map[string]int)
+	var ret []T
+	for _, row := range rows {
+		r := row.report()
+		ann := r.announced()
+		if ann.IsZero() || DateOf(ann).After(DateOf(date.In(ann.Location()))) {
+			continue
+		}
+		i, ok := index[r.Code]
+		if !ok {
+			index[r.Code] = len(ret)
+			ret = append(ret, row)
+			continue
+		}
+		last := ret[i].report()
+		c := r.EndDate.Compare(last.EndDate)
+		if c == 0 {
+			c = ann.Compare(last.announced())
+		}
+		if c > 0 || c == 0 && r.UpdateFlag == "0" && last.UpdateFlag != "0" {
+			ret[i] = row
+		}
+	}
+	return ret
+}
+
+// announced 返回报告的实际公告日期，没有时使用公告日期
+func (r Report) announced() time.Time {
+	if !r.FAnnDate.IsZero() {
+		return r.FAnnDate
+	}
+	return r.AnnDate
+}
+
+// WithReportCode 按股票代码查询
+func WithReportCode(code string) reportOpt {
+	return func(args Args) {
+		args["ts_code"] = code
+	}
+}
+
+// WithReportPeriod 按报告期查询，例如20231231表示年报，20230630表示半年报
+func WithReportPeriod(period time.Time) reportOpt {
+	return func(args Args) {
+		args["period"] = period
+	}
+}
+
+// WithReportAnnDate 按公告日期查询
+func WithReportAnnDate(date time.Time) reportOpt {
+	return func(args Args) {
+		args["ann_date"] = date
+	}
+}
+
+// WithReportAnnDateRange 按公告日期范围查询
+func WithReportAnnDateRange(start, end time.Time) reportOpt {
+	return func(args Args) {
+		args["start_date"] = start
+		args["end_date"] = end
+	}
+}
+
+// WithReportType 按报告类型查询
+func WithReportType(t reportType) reportOpt {
+	return func(args Args) {
+		args["report_type"] = t
+	}
+}
+
+// WithReportCompType 按公司类型查询
+func WithReportCompType(t reportCompType) reportOpt {
+	return func(args Args) {
+		args["comp_type"] = t
+	}
+}
@@ -0,0 +1,124 @@
+package tushare_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+// reportServer 000001.SZ的2023年三季报(有更正)及年报
+func reportServer(t *testing.T) *tushare.Client {
+	t.Helper()
+	srv := tusharetest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddRows("income", []string{
+		"ts_code", "ann_date", "f_ann_date", "end_date", "report_type", "comp_type", "update_flag", "revenue",
+	}, [][]any{
+		{"000001.SZ", "20231025", "20231025", "20230930", "1", "2", "0", 126000000000.},
+		{"000001.SZ", "20231025", "20231025", "20230930", "1", "2", "1", 126500000000.},
+		{"000001.SZ", "20240315", "20240315", "20231231", "1", "2", "0", 164699000000.},
+	})
+	return tushare.New("", tushare.WithBaseURL(srv.URL))
+}
+
+func TestReportFieldsKeepKeys(t *testing.T) {
+	cli := reportServer(t)
+	rows, err := cli.Income(tushare.WithFields("revenue"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 仅指定revenue时仍获取用于区分报告的列，两期报告不会被合并
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2: %+v", len(rows), rows)
+	}
+	if rows[0].UpdateFlag != "1" || rows[0].Revenue != 126500000000 || !rows[0].EndDate.Equal(date(2023, 9, 30)) {
+		t.Fatalf("unexpected first row %+v", rows[0])
+	}
+	if rows[1].Revenue != 164699000000 || !rows[1].EndDate.Equal(date(2023, 12, 31)) {
+		t.Fatalf("unexpected second row %+v", rows[1])
+	}
+	for _, column := range []string{"ts_code", "ann_date", "end_date", "report_type", "update_flag", "revenue"} {
+		if !rows[0].Has(column) {
+			t.Errorf("missing column %s in %v", column, rows[0].Names())
+		}
+	}
+	if rows[0].Has("comp_type") {
+		t.Errorf("unexpected column comp_type in %v", rows[0].Names())
+	}
+}
+
+func TestReportsAsOf(t *testing.T) {
+	rows := []tushare.Report{
+		// 三季报公告后于2024-03-15更正，更正后的数据沿用原公告日期
+		{Code: "A", AnnDate: date(2023, 10, 25), FAnnDate: date(2023, 10, 25), EndDate: date(2023, 9, 30), UpdateFlag: "1"},
+		{Code: "A", AnnDate: date(2023, 10, 25), EndDate: date(2023, 9, 30), UpdateFlag: "0"},
+		{Code: "A", AnnDate: date(2023, 10, 25), FAnnDate: date(2024, 3, 15), EndDate: date(2023, 9, 30), UpdateFlag: "1", EndType: "corrected"},
+		{Code: "A", AnnDate: date(2024, 3, 20), EndDate: date(2023, 12, 31), UpdateFlag: "0"},
+		{Code: "B", AnnDate: date(2022, 4, 20), EndDate: date(2021, 12, 31), UpdateFlag: "0"},
+		// 没有公告日期的数据无法确定何时可用，始终忽略
+		{Code: "B", EndDate: date(2022, 12, 31), UpdateFlag: "0"},
+		{Code: "C", EndDate: date(2022, 12, 31), UpdateFlag: "0"},
+	}
+	tests := []struct {
+		date time.Time
+		want []tushare.Report
+	}{
+		{date(2023, 10, 24), []tushare.Report{rows[4]}},
+		{date(2023, 12, 31), []tushare.Report{rows[1], rows[4]}},
+		{date(2024, 3, 15), []tushare.Report{rows[2], rows[4]}},
+		{date(2024, 3, 20), []tushare.Report{rows[3], rows[4]}},
+	}
+	for _, tt := range tests {
+		got := tushare.ReportsAsOf(rows, tt.date)
+		if len(got) != len(tt.want) {
+			t.Fatalf("as of %v: got %d rows, want %d", tt.date, len(got), len(tt.want))
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("as of %v: row %d = %+v, want %+v", tt.date, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestFinaIndicatorAsOf(t *testing.T) {
+	srv := tusharetest.NewServer()
+	defer srv.Close()
+	srv.AddRows("fina_indicator_vip", []string{"ts_code", "ann_date", "end_date", "update_flag", "eps"}, [][]any{
+		{"000001.SZ", "20231025", "20230930", "1", 0.6},
+		{"000001.SZ", "20231025", "20230930", "0", 0.5},
+		{"000001.SZ", "20240315", "20231231", "0", 2.25},
+		{"600000.SH", "20220420", "20211231", "0", 1.0},
+	})
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL))
+
+	// 使用公告时的数据，不使用之后更正的数据，且不限制开始日期
+	rows, err := cli.FinaIndicatorAsOf(time.Time{}, date(2023, 12, 31))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[0].Code != "000001.SZ" || rows[0].EPS != 0.5 || rows[0].UpdateFlag != "0" ||
+		rows[1].Code != "600000.SH" || rows[1].EPS != 1 {
+		t.Fatalf("unexpected rows %+v", rows)
+	}
+
+	rows, err = cli.FinaIndicatorAsOf(date(2023, 1, 1), date(2023, 12, 31))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Code != "000001.SZ" {
+		t.Fatalf("unexpected rows %+v", rows)
+	}
+
+	// 调用方指定的公告日期范围优先
+	rows, err = cli.FinaIndicatorAsOf(date(2023, 1, 1), date(2023, 12, 31),
+		tushare.WithFinaIndicatorAnnDateRange(date(2022, 1, 1), date(2022, 12, 31)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Code != "600000.SH" {
+		t.Fatalf("unexpected rows %+v", rows)
+	}
+}
@@ -0,0 +1,134 @@
+package tushare
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+type resamplePeriod int
+
+const (
+	ResampleWeekly    resamplePeriod = iota // 周线
+	ResampleMonthly                         // 月线
+	ResampleQuarterly                       // 季线
+	ResampleYearly                          // 年线
+)
+
+// key 返回t所在周期的标识，同一周期内的日期返回相同的值
+func (p resamplePeriod) key(t time.Time) int {
+	switch p {
+	case ResampleWeekly:
+		y, w := t.ISOWeek()
+		return y*100 + w
+	case ResampleMonthly:
+		return t.Year()*100 + int(t.Month())
+	case ResampleQuarterly:
+		return t.Year()*100 + (int(t.Month())-1)/3 + 1
+	default:
+		return t.Year()
+	}
+}
+
+// end 返回t所在周期的最后一个自然日
+func (p resamplePeriod) end(t time.Time) time.Time {
+	y, m, d := t.Date()
+	switch p {
+	case ResampleWeekly:
+		wd := (int(t.Weekday()) + 6) % 7 // 周一为0
+		return time.Date(y, m, d+6-wd, 0, 0, 0, 0, t.Location())
+	case ResampleMonthly:
+		return time.Date(y, m+1, 0, 0, 0, 0, 0, t.Location())
+	case ResampleQuarterly:
+		q := (int(m)-1)/3 + 1
+		return time.Date(y, time.Month(q*3+1), 0, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, 12, 31, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Resample 使用交易日历获取日线数据对应周期的周/月/季/年线
+func (cli *Client) Resample(ticks []DailyTick, period resamplePeriod) ([]DailyTick, error) {
+	return cli.ResampleContext(context.Background(), ticks, period)
+}
+
+// ResampleContext 使用交易日历获取日线数据对应周期的周/月/季/年线
+func (cli *Client) ResampleContext(ctx context.Context, ticks []DailyTick, period resamplePeriod) ([]DailyTick, error) {
+	if len(ticks) == 0 {
+		return nil, nil
+	}
+	first := slices.MinFunc(ticks, func(a, b DailyTick) int { return a.Time.Compare(b.Time) }).Time
+	last := slices.MaxFunc(ticks, func(a, b DailyTick) int { return a.Time.Compare(b.Time) }).Time
+	cal, err := cli.TradeCalContext(ctx, first, period.end(last))
+	if err != nil {
+		return nil, err
+	}
+	return Resample(ticks, period, cal), nil
+}
+
+// Resample 将日线数据聚合为周/月/季/年线，支持多个代码的数据，结果按代码及日期升序排列
+//
+// 开盘价为周期内首个开盘价，最高最低价为周期内的极值，收盘价为周期内最后一个收盘价，
+// 成交量及成交额为周期内的合计，昨收价为上一周期的收盘价，涨跌额及涨跌幅按昨收价重新计算
+//
+// cal为交易日历，不为空时每个周期的日期为该周期内最后一个交易日(不晚于数据中的最后一天)，
+// 为空时使用周期内最后一条数据的日期
+func Resample(ticks []DailyTick, period resamplePeriod, cal []time.Time) []DailyTick {
+	sorted := slices.Clone(ticks)
+	slices.SortFunc(sorted, func(a, b DailyTick) int {
+		if a.Code != b.Code {
+			if a.Code < b.Code {
+				return -1
+			}
+			return 1
+		}
+		return a.Time.Compare(b.Time)
+	})
+	var last time.Time
+	for _, t := range sorted {
+		if t.Time.After(last) {
+			last = t.Time
+		}
+	}
+	// 每个周期内最后一个交易日
+	labels := make(map[int]time.Time)
+	for _, day := range cal {
+		if day.After(last) {
+			continue
+		}
+		k := period.key(day)
+		if day.After(labels[k]) {
+			labels[k] = day
+		}
+	}
+
+	var ret []DailyTick
+	for i := 0; i < len(sorted); {
+		var bar DailyTick
+		start := i
+		k := period.key(sorted[i].Time)
+		for i < len(sorted) && sorted[i].Code == sorted[start].Code && period.key(sorted[i].Time) == k {
+			t := sorted[i]
+			if i == start {
+				bar = t
+				bar.Volume, bar.Turnover = 0, 0
+			}
+			bar.High = max(bar.High, t.High)
+			bar.Low = min(bar.Low, t.Low)
+			bar.Close = t.Close
+			bar.Time = t.Time
+			bar.Volume += t.Volume
+			bar.Turnover += t.Turnover
+			i++
+		}
+		if label, ok := labels[k]; ok && label.After(bar.Time) {
+			bar.Time = label
+		}
+		if n := len(ret); n > 0 && ret[n-1].Code == bar.Code {
+			bar.PreClose = ret[n-1].Close
+		}
+		bar.recalc()
+		ret = append(ret, bar)
+	}
+	return ret
+}
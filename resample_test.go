@@ -0,0 +1,101 @@
+package tushare
+
+import (
+	"testing"
+	"time"
+)
+
+func resampleTick(code string, date time.Time, open, high, low, close, preClose, vol float64) DailyTick {
+	t := testTick(code, date, open, high, low, close, preClose)
+	t.Volume, t.Turnover = vol, vol*10
+	return t
+}
+
+func weekdays(start, end time.Time) []time.Time {
+	var days []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+func TestResampleWeekly(t *testing.T) {
+	ticks := []DailyTick{
+		resampleTick("A", testDay(2024, 1, 8), 10, 11, 9.5, 10.5, 9.8, 100),
+		resampleTick("A", testDay(2024, 1, 9), 10.5, 12, 10, 11, 10.5, 200),
+		resampleTick("A", testDay(2024, 1, 12), 11, 11.5, 10.8, 11.2, 11, 300),
+		resampleTick("A", testDay(2024, 1, 15), 11.2, 11.6, 11, 11.4, 11.2, 400),
+		resampleTick("A", testDay(2024, 1, 16), 11.4, 11.8, 11.3, 11.5, 11.4, 500),
+		// B在1月10日之后停牌
+		resampleTick("B", testDay(2024, 1, 10), 20, 21, 19, 20.5, 20, 50),
+	}
+	cal := weekdays(testDay(2024, 1, 8), testDay(2024, 1, 19))
+	got := Resample(ticks, ResampleWeekly, cal)
+	if len(got) != 3 {
+		t.Fatalf("got %d bars, want 3: %+v", len(got), got)
+	}
+
+	first := got[0]
+	if first.Code != "A" || !first.Time.Equal(testDay(2024, 1, 12)) ||
+		first.Open != 10 || first.High != 12 || first.Low != 9.5 || first.Close != 11.2 ||
+		first.Volume != 600 || first.Turnover != 6000 || first.PreClose != 9.8 {
+		t.Fatalf("first bar = %+v", first)
+	}
+	// 数据截止到1月16日，未结束的一周以最后一天的数据为准
+	second := got[1]
+	if !second.Time.Equal(testDay(2024, 1, 16)) || second.Open != 11.2 || second.Close != 11.5 || second.Volume != 900 {
+		t.Fatalf("second bar = %+v", second)
+	}
+	// 昨收价为上一周期的收盘价
+	if second.PreClose != 11.2 || round(second.Change, 2) != 0.3 || round(second.PctChg, 4) != round(0.3/11.2*100, 4) {
+		t.Fatalf("second bar prev close = %v, change = %v, pct = %v", second.PreClose, second.Change, second.PctChg)
+	}
+	// 停牌的B使用该周最后一个交易日作为日期，不继承A的收盘价
+	b := got[2]
+	if b.Code != "B" || !b.Time.Equal(testDay(2024, 1, 12)) || b.PreClose != 20 || b.Close != 20.5 {
+		t.Fatalf("B bar = %+v", b)
+	}
+}
+
+func TestResampleWeeklyYearBoundary(t *testing.T) {
+	// 2024-12-30至2025-01-03属于ISO周2025-W01
+	var ticks []DailyTick
+	for _, d := range []time.Time{testDay(2024, 12, 27), testDay(2024, 12, 30), testDay(2024, 12, 31), testDay(2025, 1, 2), testDay(2025, 1, 3)} {
+		ticks = append(ticks, resampleTick("A", d, 1, 2, 0.5, 1.5, 1, 10))
+	}
+	got := Resample(ticks, ResampleWeekly, nil)
+	if len(got) != 2 {
+		t.Fatalf("got %d bars, want 2: %+v", len(got), got)
+	}
+	if !got[0].Time.Equal(testDay(2024, 12, 27)) || !got[1].Time.Equal(testDay(2025, 1, 3)) || got[1].Volume != 40 {
+		t.Fatalf("bars = %+v", got)
+	}
+}
+
+func TestResampleMonthlyQuarterlyYearly(t *testing.T) {
+	var ticks []DailyTick
+	for _, d := range []time.Time{testDay(2024, 1, 31), testDay(2024, 2, 1), testDay(2024, 3, 29), testDay(2024, 4, 1)} {
+		ticks = append(ticks, resampleTick("A", d, 1, 2, 0.5, 1.5, 1, 10))
+	}
+	tests := []struct {
+		period resamplePeriod
+		dates  []time.Time
+	}{
+		{ResampleMonthly, []time.Time{testDay(2024, 1, 31), testDay(2024, 2, 1), testDay(2024, 3, 29), testDay(2024, 4, 1)}},
+		{ResampleQuarterly, []time.Time{testDay(2024, 3, 29), testDay(2024, 4, 1)}},
+		{ResampleYearly, []time.Time{testDay(2024, 4, 1)}},
+	}
+	for _, tt := range tests {
+		got := Resample(ticks, tt.period, nil)
+		if len(got) != len(tt.dates) {
+			t.Fatalf("period %d: got %d bars, want %d", tt.period, len(got), len(tt.dates))
+		}
+		for i, d := range tt.dates {
+			if !got[i].Time.Equal(d) {
+				t.Errorf("period %d bar %d: date = %v, want %v", tt.period, i, got[i].Time, d)
+			}
+		}
+	}
+}
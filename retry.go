@@ -0,0 +1,112 @@
+package tushare
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy 重试策略
+type RetryPolicy struct {
+	MaxAttempts int              // 最大尝试次数(含首次请求)
+	BaseDelay   time.Duration    // 首次重试前的等待时间，之后按指数增长
+	MaxDelay    time.Duration    // 单次等待时间上限
+	Jitter      float64          // 随机抖动比例(0~1)
+	Retryable   func(error) bool // 判断错误是否可重试，为空时使用DefaultRetryable
+}
+
+// DefaultRetryPolicy 默认重试策略
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 10,
+	BaseDelay:   time.Second,
+	MaxDelay:    time.Minute,
+	Jitter:      0.2,
+	Retryable:   DefaultRetryable,
+}
+
+// NoRetry 不进行重试
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// WithRetryPolicy 设置重试策略
+func WithRetryPolicy(policy RetryPolicy) clientOpt {
+	return func(cli *Client) {
+		cli.retry = policy
+	}
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	if p.Retryable == nil {
+		return DefaultRetryable(err)
+	}
+	return p.Retryable(err)
+}
+
+// delay 计算第n次失败后的等待时间
+func (p RetryPolicy) delay(n int) time.Duration {
+	d := p.BaseDelay
+	for range n {
+		// 未设置MaxDelay时在溢出前停止增长
+		if p.MaxDelay > 0 && d >= p.MaxDelay || d > math.MaxInt64/2 {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		f := float64(d) * (1 + p.Jitter*(2*rand.Float64()-1))
+		if f >= math.MaxInt64 {
+			return math.MaxInt64
+		}
+		d = time.Duration(f)
+	}
+	return max(d, 0)
+}
+
+// Permanent 包装err，DefaultRetryable不会重试包装后的错误，
+// 可用于自定义http.RoundTripper返回重试也无法恢复的错误，errors.Is及errors.As仍可匹配err
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// DefaultRetryable 默认错误分类：
+// 网络错误、HTTP 5xx及频率超限可重试，token无效、无权限、参数错误及Permanent包装的错误直接返回
+func DefaultRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var p *permanentError
+	if errors.As(err, &p) {
+		return false
+	}
+	var e *APIError
+	if errors.As(err, &e) {
+		if e.Status != http.StatusOK {
+			return e.Status >= 500 || e.Status == http.StatusTooManyRequests
+		}
+		return !errors.Is(e, ErrInvalidToken) &&
+			!errors.Is(e, ErrNoPermission) &&
+			!errors.Is(e, ErrInvalidParams)
+	}
+	// 网络错误、响应解析失败等
+	return true
+}
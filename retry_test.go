@@ -0,0 +1,36 @@
+package tushare
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	for n, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		if got := p.delay(n); got != want {
+			t.Errorf("delay(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestRetryDelayNoMax(t *testing.T) {
+	for _, jitter := range []float64{0, 0.5} {
+		p := RetryPolicy{BaseDelay: time.Second, Jitter: jitter}
+		prev := time.Duration(0)
+		for n := range 100 {
+			got := p.delay(n)
+			if got <= 0 {
+				t.Fatalf("jitter %v: delay(%d) = %v, want positive", jitter, n, got)
+			}
+			if jitter == 0 && got < prev {
+				t.Fatalf("delay(%d) = %v, less than delay(%d) = %v", n, got, n-1, prev)
+			}
+			prev = got
+		}
+		if got := p.delay(100); got < math.MaxInt64/4 {
+			t.Errorf("jitter %v: delay(100) = %v, want close to the maximum", jitter, got)
+		}
+	}
+}
@@ -0,0 +1,376 @@
+package tushare
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Table 接口返回的二维表，可用于尚未封装的接口
+type Table struct {
+	Fields   []string       // 列名
+	Items    [][]any        // 数据
+	Location *time.Location // 解析日期时使用的时区，为空时使用Shanghai
+}
+
+// Query 调用任意接口并自动翻页，返回二维表
+func (cli *Client) Query(api string, args Args, fields []string) (*Table, error) {
+	return cli.QueryContext(context.Background(), api, args, fields)
+}
+
+// QueryContext 调用任意接口并自动翻页，返回二维表
+func (cli *Client) QueryContext(ctx context.Context, api string, args Args, fields []string) (*Table, error) {
+	columns, items, err := cli.CallAllContext(ctx, api, args, fields)
+	if err != nil {
+		return nil, err
+	}
+	return &Table{Fields: columns, Items: items, Location: cli.loc}, nil
+}
+
+// Len 返回行数
+func (t *Table) Len() int {
+	return len(t.Items)
+}
+
+// Index 返回列的下标，列不存在时返回-1
+func (t *Table) Index(column string) int {
+	return slices.Index(t.Fields, column)
+}
+
+// Has 判断是否包含指定的列
+func (t *Table) Has(column string) bool {
+	return t.Index(column) >= 0
+}
+
+// Row 返回第i行
+func (t *Table) Row(i int) Row {
+	return Row{t: t, i: i}
+}
+
+// Rows 逐行遍历
+func (t *Table) Rows() iter.Seq2[int, Row] {
+	return func(yield func(int, Row) bool) {
+		for i := range t.Items {
+			if !yield(i, t.Row(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values 返回指定列的原始数据，列不存在时返回nil
+func (t *Table) Values(column string) []any {
+	idx := t.Index(column)
+	if idx < 0 {
+		return nil
+	}
+	ret := make([]any, len(t.Items))
+	for i := range t.Items {
+		ret[i] = t.Row(i).value(idx)
+	}
+	return ret
+}
+
+// Float64s 返回指定列的数值，null或无法转换的值为NaN，列不存在时返回nil
+func (t *Table) Float64s(column string) []float64 {
+	idx := t.Index(column)
+	if idx < 0 {
+		return nil
+	}
+	ret := make([]float64, len(t.Items))
+	for i := range t.Items {
+		ret[i] = t.Row(i).float64(idx)
+	}
+	return ret
+}
+
+// Ints 返回指定列的整数值，null或无法转换的值为0，列不存在时返回nil
+func (t *Table) Ints(column string) []int {
+	idx := t.Index(column)
+	if idx < 0 {
+		return nil
+	}
+	ret := make([]int, len(t.Items))
+	for i := range t.Items {
+		ret[i] = t.Row(i).int(idx)
+	}
+	return ret
+}
+
+// Strings 返回指定列的字符串，null为空字符串，列不存在时返回nil
+func (t *Table) Strings(column string) []string {
+	idx := t.Index(column)
+	if idx < 0 {
+		return nil
+	}
+	ret := make([]string, len(t.Items))
+	for i := range t.Items {
+		ret[i] = t.Row(i).string(idx)
+	}
+	return ret
+}
+
+// Dates 返回按yyyymmdd格式解析的日期，null或无法解析的值为零值，列不存在时返回nil
+func (t *Table) Dates(column string) []time.Time {
+	idx := t.Index(column)
+	if idx < 0 {
+		return nil
+	}
+	ret := make([]time.Time, len(t.Items))
+	for i := range t.Items {
+		ret[i] = t.Row(i).date(idx)
+	}
+	return ret
+}
+
+// Filter 返回满足条件的行组成的新表，新表与原表共享行数据
+func (t *Table) Filter(fn func(Row) bool) *Table {
+	ret := &Table{Fields: t.Fields, Location: t.Location}
+	for i, row := range t.Rows() {
+		if fn(row) {
+			ret.Items = append(ret.Items, t.Items[i])
+		}
+	}
+	return ret
+}
+
+// Select 返回仅包含指定列的新表，不存在的列会被忽略
+func (t *Table) Select(columns ...string) *Table {
+	var idx []int
+	ret := &Table{Location: t.Location}
+	for _, column := range columns {
+		if i := t.Index(column); i >= 0 {
+			idx = append(idx, i)
+			ret.Fields = append(ret.Fields, column)
+		}
+	}
+	ret.Items = make([][]any, len(t.Items))
+	for i := range t.Items {
+		item := make([]any, len(idx))
+		for j, k := range idx {
+			item[j] = t.Row(i).value(k)
+		}
+		ret.Items[i] = item
+	}
+	return ret
+}
+
+// SortFunc 按cmp的比较结果对行进行稳定排序
+func (t *Table) SortFunc(cmp func(a, b Row) int) {
+	slices.SortStableFunc(t.Items, func(a, b []any) int {
+		return cmp(Row{t: t, item: a}, Row{t: t, item: b})
+	})
+}
+
+// SortBy 按指定的列升序排序，列名以-开头时降序排序，例如SortBy("ts_code", "-trade_date")
+func (t *Table) SortBy(columns ...string) {
+	type key struct {
+		idx  int
+		desc bool
+	}
+	var keys []key
+	for _, column := range columns {
+		desc := strings.HasPrefix(column, "-")
+		if idx := t.Index(strings.TrimPrefix(column, "-")); idx >= 0 {
+			keys = append(keys, key{idx: idx, desc: desc})
+		}
+	}
+	t.SortFunc(func(a, b Row) int {
+		for _, k := range keys {
+			n := compareValue(a.value(k.idx), b.value(k.idx))
+			if k.desc {
+				n = -n
+			}
+			if n != 0 {
+				return n
+			}
+		}
+		return 0
+	})
+}
+
+// Join 按指定的列内连接两张表，右表中与左表同名的非连接列会加上_right后缀，
+// 任一表中不存在连接列时panic
+func (t *Table) Join(other *Table, keys ...string) *Table {
+	return t.join(other, false, keys)
+}
+
+// LeftJoin 按指定的列左连接两张表，右表中没有匹配的行时对应的列为null，
+// 任一表中不存在连接列时panic
+func (t *Table) LeftJoin(other *Table, keys ...string) *Table {
+	return t.join(other, true, keys)
+}
+
+func (t *Table) join(other *Table, left bool, keys []string) *Table {
+	if len(keys) == 0 {
+		panic("tushare: join without key columns")
+	}
+	// 连接列不存在时所有行的键都相同，会变为笛卡尔积
+	for _, key := range keys {
+		if !t.Has(key) || !other.Has(key) {
+			panic(fmt.Sprintf("tushare: join key column %s not found", key))
+		}
+	}
+	ret := &Table{Fields: slices.Clone(t.Fields), Location: t.Location}
+	var rightIdx []int
+	for i, field := range other.Fields {
+		if slices.Contains(keys, field) {
+			continue
+		}
+		if slices.Contains(ret.Fields, field) {
+			field += "_right"
+		}
+		ret.Fields = append(ret.Fields, field)
+		rightIdx = append(rightIdx, i)
+	}
+	index := make(map[string][]int)
+	for i := range other.Items {
+		k := other.Row(i).key(keys)
+		index[k] = append(index[k], i)
+	}
+	for i, item := range t.Items {
+		matched := index[t.Row(i).key(keys)]
+		if len(matched) == 0 && left {
+			row := make([]any, len(ret.Fields))
+			copy(row, item)
+			ret.Items = append(ret.Items, row)
+			continue
+		}
+		for _, j := range matched {
+			row := make([]any, 0, len(ret.Fields))
+			row = append(row, item...)
+			row = row[:len(t.Fields)]
+			for _, k := range rightIdx {
+				row = append(row, other.Row(j).value(k))
+			}
+			ret.Items = append(ret.Items, row)
+		}
+	}
+	return ret
+}
+
+// Row 表中的一行
+type Row struct {
+	t    *Table
+	i    int
+	item []any
+}
+
+func (r Row) row() []any {
+	if r.item != nil {
+		return r.item
+	}
+	return r.t.Items[r.i]
+}
+
+func (r Row) value(idx int) any {
+	row := r.row()
+	if idx < 0 || idx >= len(row) {
+		return nil
+	}
+	return row[idx]
+}
+
+func (r Row) float64(idx int) float64 {
+	v := r.value(idx)
+	if v == nil {
+		return math.NaN()
+	}
+	f, err := toFloat(v)
+	if err != nil {
+		return math.NaN()
+	}
+	return f
+}
+
+func (r Row) int(idx int) int {
+	f, _ := toFloat(r.value(idx))
+	return int(f)
+}
+
+func (r Row) string(idx int) string {
+	switch v := r.value(idx).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (r Row) date(idx int) time.Time {
+	loc := r.t.Location
+	if loc == nil {
+		loc = Shanghai
+	}
+	t, _ := time.ParseInLocation("20060102", r.string(idx), loc)
+	return t
+}
+
+func (r Row) key(columns []string) string {
+	var sb strings.Builder
+	for _, column := range columns {
+		sb.WriteString(r.String(column))
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// Value 返回指定列的原始数据
+func (r Row) Value(column string) any {
+	return r.value(r.t.Index(column))
+}
+
+// Float64 返回指定列的数值，null或无法转换的值为NaN
+func (r Row) Float64(column string) float64 {
+	return r.float64(r.t.Index(column))
+}
+
+// Int 返回指定列的整数值，null或无法转换的值为0
+func (r Row) Int(column string) int {
+	return r.int(r.t.Index(column))
+}
+
+// String 返回指定列的字符串，null为空字符串
+func (r Row) String(column string) string {
+	return r.string(r.t.Index(column))
+}
+
+// Date 返回按yyyymmdd格式解析的日期，null或无法解析的值为零值
+func (r Row) Date(column string) time.Time {
+	return r.date(r.t.Index(column))
+}
+
+// compareValue 比较两个单元格的值，null小于任何值，数字小于字符串
+func compareValue(a, b any) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	fa, errA := toFloat(a)
+	fb, errB := toFloat(b)
+	switch {
+	case errA == nil && errB == nil:
+		return cmp.Compare(fa, fb)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
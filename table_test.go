@@ -0,0 +1,153 @@
+package tushare
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTableNumericStrings(t *testing.T) {
+	tbl := &Table{
+		Fields: []string{"trade_date", "vol"},
+		Items: [][]any{
+			{20240102.0, 12345678.0},
+			{"20240103", 0.5},
+		},
+	}
+	if got := tbl.Strings("vol"); !slices.Equal(got, []string{"12345678", "0.5"}) {
+		t.Fatalf("Strings = %q", got)
+	}
+	want := []time.Time{
+		time.Date(2024, 1, 2, 0, 0, 0, 0, Shanghai),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, Shanghai),
+	}
+	got := tbl.Dates("trade_date")
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Fatalf("Dates = %v, want %v", got, want)
+	}
+}
+
+func testTable() *Table {
+	return &Table{
+		Fields: []string{"ts_code", "trade_date", "close"},
+		Items: [][]any{
+			{"000002.SZ", "20240102", 7.5},
+			{"000001.SZ", "20240103", nil},
+			{"000001.SZ", "20240102", 9.39},
+			{"600000.SH", "20240102", "6.6"},
+		},
+	}
+}
+
+func TestTableFilterSelect(t *testing.T) {
+	tbl := testTable()
+	got := tbl.Filter(func(r Row) bool { return r.String("ts_code") == "000001.SZ" })
+	if got.Len() != 2 || !slices.Equal(got.Fields, tbl.Fields) ||
+		!slices.Equal(got.Strings("trade_date"), []string{"20240103", "20240102"}) {
+		t.Fatalf("Filter = %+v", got)
+	}
+
+	got = tbl.Select("close", "missing", "ts_code")
+	if !slices.Equal(got.Fields, []string{"close", "ts_code"}) || got.Len() != 4 {
+		t.Fatalf("Select = %+v", got)
+	}
+	if got.Items[0][0] != 7.5 || got.Items[0][1] != "000002.SZ" || got.Items[1][0] != nil {
+		t.Fatalf("Select items = %v", got.Items)
+	}
+	// 修改新表不影响原表
+	got.Items[0][0] = 0.
+	if tbl.Items[0][2] != 7.5 {
+		t.Fatal("Select shares cells with the source table")
+	}
+}
+
+func TestTableSortBy(t *testing.T) {
+	tbl := testTable()
+	tbl.SortBy("ts_code", "-trade_date")
+	want := [][2]string{
+		{"000001.SZ", "20240103"},
+		{"000001.SZ", "20240102"},
+		{"000002.SZ", "20240102"},
+		{"600000.SH", "20240102"},
+	}
+	for i, w := range want {
+		if r := tbl.Row(i); r.String("ts_code") != w[0] || r.String("trade_date") != w[1] {
+			t.Fatalf("row %d = %v, want %v", i, tbl.Items[i], w)
+		}
+	}
+
+	// null最小，数字及数字字符串按数值比较，其余字符串排在数字之后
+	tbl = &Table{
+		Fields: []string{"v"},
+		Items:  [][]any{{"b"}, {10.}, {nil}, {"9.5"}, {"a"}, {-1.}},
+	}
+	tbl.SortBy("v")
+	if got := tbl.Values("v"); !slices.Equal(got, []any{nil, -1., "9.5", 10., "a", "b"}) {
+		t.Fatalf("ascending = %v", got)
+	}
+	tbl.SortBy("-v")
+	if got := tbl.Values("v"); !slices.Equal(got, []any{"b", "a", 10., "9.5", -1., nil}) {
+		t.Fatalf("descending = %v", got)
+	}
+	// 不存在的列被忽略，保持原有顺序
+	tbl.SortBy("missing")
+	if got := tbl.Values("v"); got[0] != "b" || got[5] != nil {
+		t.Fatalf("unknown column = %v", got)
+	}
+}
+
+func TestTableJoin(t *testing.T) {
+	left := testTable()
+	right := &Table{
+		Fields: []string{"ts_code", "trade_date", "close", "turnover_rate"},
+		Items: [][]any{
+			{"000001.SZ", "20240102", 9.4, 0.6},
+			{"000002.SZ", "20240102", 7.5, 1.2},
+			{"000002.SZ", "20240102", 7.6, 1.3},
+		},
+	}
+	got := left.Join(right, "ts_code", "trade_date")
+	if want := []string{"ts_code", "trade_date", "close", "close_right", "turnover_rate"}; !slices.Equal(got.Fields, want) {
+		t.Fatalf("fields = %v, want %v", got.Fields, want)
+	}
+	// 000002.SZ在右表中有两行匹配
+	want := [][]any{
+		{"000002.SZ", "20240102", 7.5, 7.5, 1.2},
+		{"000002.SZ", "20240102", 7.5, 7.6, 1.3},
+		{"000001.SZ", "20240102", 9.39, 9.4, 0.6},
+	}
+	if !slices.EqualFunc(got.Items, want, slices.Equal) {
+		t.Fatalf("Join = %v, want %v", got.Items, want)
+	}
+
+	got = left.LeftJoin(right, "ts_code", "trade_date")
+	want = [][]any{
+		{"000002.SZ", "20240102", 7.5, 7.5, 1.2},
+		{"000002.SZ", "20240102", 7.5, 7.6, 1.3},
+		{"000001.SZ", "20240103", nil, nil, nil},
+		{"000001.SZ", "20240102", 9.39, 9.4, 0.6},
+		{"600000.SH", "20240102", "6.6", nil, nil},
+	}
+	if !slices.EqualFunc(got.Items, want, slices.Equal) {
+		t.Fatalf("LeftJoin = %v, want %v", got.Items, want)
+	}
+	if f := got.Float64s("close_right"); f[0] != 7.5 || !math.IsNaN(f[2]) {
+		t.Fatalf("close_right = %v", f)
+	}
+}
+
+func TestTableJoinMissingKey(t *testing.T) {
+	left := testTable()
+	right := &Table{Fields: []string{"code", "trade_date"}, Items: [][]any{{"000001.SZ", "20240102"}}}
+	for _, keys := range [][]string{{"ts_code"}, {"trade_date", "missing"}, nil} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Join(%v) did not panic", keys)
+				}
+			}()
+			left.Join(right, keys...)
+		}()
+	}
+}
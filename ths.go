@@ -1,74 +1,39 @@
 package tushare
 
-import "time"
+import (
+	"context"
+	"slices"
+	"time"
+)
 
 // https://tushare.pro/document/2?doc_id=259
 
 // ThsIndex 同花顺行业指数数据
 type ThsIndex struct {
-	Code     string    // 指数代码
-	Name     string    // 指数名称
-	Count    int       // 成分股数量
-	Exchange string    // 交易所代码
-	Date     time.Time // 上市日期
-	Type     string    // 指数类型
+	Columns  `json:"-"`
+	Code     string    `tushare:"ts_code"`        // 指数代码
+	Name     string    `tushare:"name"`           // 指数名称
+	Count    int       `tushare:"count"`          // 成分股数量
+	Exchange string    `tushare:"exchange"`       // 交易所代码
+	Date     time.Time `tushare:"list_date,date"` // 上市日期
+	Type     string    `tushare:"type"`           // 指数类型
 }
 
 type thsIndexOpt func(Args)
 
 // ThsIndex 获取同花顺行业指数数据
 func (cli *Client) ThsIndex(opts ...thsIndexOpt) ([]ThsIndex, error) {
+	return cli.ThsIndexContext(context.Background(), opts...)
+}
+
+// ThsIndexContext 获取同花顺行业指数数据
+func (cli *Client) ThsIndexContext(ctx context.Context, opts ...thsIndexOpt) ([]ThsIndex, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call("ths_index", args,
+	return query[ThsIndex](ctx, cli, "ths_index", args,
 		[]string{"ts_code", "name", "count", "exchange", "list_date", "type"})
-	if err != nil {
-		return nil, err
-	}
-	var idxCode, idxName, idxCount, idxExchange, idxDate, idxType int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "name":
-			idxName = i
-		case "count":
-			idxCount = i
-		case "exchange":
-			idxExchange = i
-		case "list_date":
-			idxDate = i
-		case "type":
-			idxType = i
-		}
-	}
-	toString := func(v any) string {
-		if v == nil {
-			return ""
-		}
-		return v.(string)
-	}
-	toInt := func(v any) int {
-		if v == nil {
-			return 0
-		}
-		return int(v.(float64))
-	}
-	items := make([]ThsIndex, len(data))
-	for i, item := range data {
-		date, _ := time.ParseInLocation("20060102", toString(item[idxDate]), time.Local)
-		items[i] = ThsIndex{
-			Code:     item[idxCode].(string),
-			Name:     item[idxName].(string),
-			Count:    toInt(item[idxCount]),
-			Exchange: item[idxExchange].(string),
-			Date:     date,
-			Type:     item[idxType].(string),
-		}
-	}
-	return items, nil
 }
 
 // WithThsIndexCode 同花顺行业指数代码参数
@@ -112,44 +77,31 @@ func WithThsIndexType(indexType thsIndexType) thsIndexOpt {
 
 // ThsMember 同花顺行业成分股
 type ThsMember struct {
-	IndexCode string // 指数代码
-	StockCode string // 成分股代码
-	StockName string // 成分股名称
+	Columns   `json:"-"`
+	IndexCode string    `tushare:"ts_code"`       // 指数代码
+	StockCode string    `tushare:"con_code"`      // 成分股代码
+	StockName string    `tushare:"con_name"`      // 成分股名称
+	Weight    float64   `tushare:"weight"`        // 权重
+	InDate    time.Time `tushare:"in_date,date"`  // 纳入日期
+	OutDate   time.Time `tushare:"out_date,date"` // 剔除日期
+	IsNew     string    `tushare:"is_new"`        // 是否最新(Y是/N否)
 }
 
 type thsMemberOpt func(Args)
 
 // ThsMember 获取同花顺行业成分股
 func (cli *Client) ThsMember(opts ...thsMemberOpt) ([]ThsMember, error) {
+	return cli.ThsMemberContext(context.Background(), opts...)
+}
+
+// ThsMemberContext 获取同花顺行业成分股
+func (cli *Client) ThsMemberContext(ctx context.Context, opts ...thsMemberOpt) ([]ThsMember, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call("ths_member", args,
+	return query[ThsMember](ctx, cli, "ths_member", args,
 		[]string{"ts_code", "con_code", "con_name"})
-	if err != nil {
-		return nil, err
-	}
-	var idxIndexCode, idxStockCode, idxStockName int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxIndexCode = i
-		case "con_code":
-			idxStockCode = i
-		case "con_name":
-			idxStockName = i
-		}
-	}
-	items := make([]ThsMember, len(data))
-	for i, item := range data {
-		items[i] = ThsMember{
-			IndexCode: item[idxIndexCode].(string),
-			StockCode: item[idxStockCode].(string),
-			StockName: item[idxStockName].(string),
-		}
-	}
-	return items, nil
 }
 
 // WithThsMemberIndexCode 同花顺行业指数代码参数
@@ -170,11 +122,16 @@ type thsDailyOpt func(Args)
 
 // ThsDaily 同花顺行业指数日线行情
 func (cli *Client) ThsDaily(opts ...thsDailyOpt) ([]DailyTick, error) {
+	return cli.ThsDailyContext(context.Background(), opts...)
+}
+
+// ThsDailyContext 同花顺行业指数日线行情
+func (cli *Client) ThsDailyContext(ctx context.Context, opts ...thsDailyOpt) ([]DailyTick, error) {
 	args := make(Args)
 	for _, o := range opts {
 		o(args)
 	}
-	fields, data, err := cli.Call("ths_daily", args, []string{
+	rows, err := query[thsDaily](ctx, cli, "ths_daily", args, []string{
 		"ts_code", "trade_date",
 		"open", "high", "low", "close",
 		"pre_close", "change", "pct_change",
@@ -182,61 +139,47 @@ func (cli *Client) ThsDaily(opts ...thsDailyOpt) ([]DailyTick, error) {
 	if err != nil {
 		return nil, err
 	}
-	var idxCode, idxDate int
-	var idxOpen, idxHigh, idxLow, idxClose int
-	var idxPreClose, idxChange, idxPctChg int
-	var idxVolume int
-	for i, field := range fields {
-		switch field {
-		case "ts_code":
-			idxCode = i
-		case "trade_date":
-			idxDate = i
-		case "open":
-			idxOpen = i
-		case "high":
-			idxHigh = i
-		case "low":
-			idxLow = i
-		case "close":
-			idxClose = i
-		case "pre_close":
-			idxPreClose = i
-		case "change":
-			idxChange = i
-		case "pct_change":
-			idxPctChg = i
-		case "vol":
-			idxVolume = i
-		}
-	}
-	items := make([]DailyTick, len(data))
-	toFloat := func(v any) float64 {
-		if v == nil {
-			return 0
+	items := make([]DailyTick, len(rows))
+	for i, row := range rows {
+		// 按DailyTick的列名记录返回的列
+		names := row.Names()
+		if j := slices.Index(names, "pct_change"); j >= 0 {
+			names[j] = "pct_chg"
 		}
-		return v.(float64)
-	}
-	for i, item := range data {
-		date, _ := time.ParseInLocation("20060102", item[idxDate].(string), time.Local)
 		items[i] = DailyTick{
 			Tick: Tick{
-				Code:   item[idxCode].(string),
-				Time:   date,
-				Open:   toFloat(item[idxOpen]),
-				High:   toFloat(item[idxHigh]),
-				Low:    toFloat(item[idxLow]),
-				Close:  toFloat(item[idxClose]),
-				Volume: toFloat(item[idxVolume]),
+				Columns: newColumns(names),
+				Code:    row.Code,
+				Time:    row.Time,
+				Open:    row.Open,
+				High:    row.High,
+				Low:     row.Low,
+				Close:   row.Close,
+				Volume:  row.Volume,
 			},
-			PreClose: toFloat(item[idxPreClose]),
-			Change:   toFloat(item[idxChange]),
-			PctChg:   toFloat(item[idxPctChg]),
+			PreClose: row.PreClose,
+			Change:   row.Change,
+			PctChg:   row.PctChg,
 		}
 	}
 	return items, nil
 }
 
+// thsDaily ths_daily接口的涨跌幅字段为pct_change，且不包含成交额
+type thsDaily struct {
+	Columns  `json:"-"`
+	Code     string    `tushare:"ts_code"`
+	Time     time.Time `tushare:"trade_date,date"`
+	Open     float64   `tushare:"open"`
+	High     float64   `tushare:"high"`
+	Low      float64   `tushare:"low"`
+	Close    float64   `tushare:"close"`
+	Volume   float64   `tushare:"vol"`
+	PreClose float64   `tushare:"pre_close"`
+	Change   float64   `tushare:"change"`
+	PctChg   float64   `tushare:"pct_change"`
+}
+
 // WithThsDailyCode 同花顺行业指数代码参数
 func WithThsDailyCode(code string) thsDailyOpt {
 	return func(args Args) {
@@ -247,14 +190,14 @@ func WithThsDailyCode(code string) thsDailyOpt {
 // WithThsDailyDate 按日期查询
 func WithThsDailyDate(date time.Time) thsDailyOpt {
 	return func(args Args) {
-		args["trade_date"] = date.Format("20060102")
+		args["trade_date"] = date
 	}
 }
 
 // WithThsDailyDateRange 按日期范围查询
 func WithThsDailyDateRange(start, end time.Time) thsDailyOpt {
 	return func(args Args) {
-		args["start_date"] = start.Format("20060102")
-		args["end_date"] = end.Format("20060102")
+		args["start_date"] = start
+		args["end_date"] = end
 	}
 }
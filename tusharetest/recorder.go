@@ -0,0 +1,224 @@
+// Package tusharetest 提供用于离线测试的工具
+package tusharetest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lwch/tushare"
+)
+
+// Mode 录制模式
+type Mode int
+
+const (
+	// Replay 仅从文件中回放，文件不存在时返回错误
+	Replay Mode = iota
+	// Record 请求真实接口并将结果写入文件
+	Record
+	// ReplayOrRecord 文件存在时回放，否则请求真实接口并写入文件
+	ReplayOrRecord
+)
+
+// RecordEnv 设置该环境变量时ModeFromEnv返回Record
+const RecordEnv = "TUSHARE_RECORD"
+
+// ModeFromEnv 根据环境变量TUSHARE_RECORD决定录制或回放
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return Record
+	}
+	return Replay
+}
+
+// ErrNotRecorded 回放时找不到对应的录制文件，该错误不会被tushare.DefaultRetryable重试
+var ErrNotRecorded = errors.New("tusharetest: request not recorded")
+
+// Recorder 录制及回放tushare请求的http.RoundTripper，
+// 按api_name、params及fields区分不同的请求，录制文件中不包含token
+type Recorder struct {
+	Dir       string            // 录制文件所在目录
+	Mode      Mode              // 录制模式
+	Transport http.RoundTripper // 录制时使用的底层Transport，为空时使用http.DefaultTransport
+
+	mu sync.Mutex
+}
+
+// NewRecorder 创建Recorder
+func NewRecorder(dir string, mode Mode) *Recorder {
+	return &Recorder{Dir: dir, Mode: mode}
+}
+
+// HTTPClient 返回使用Recorder的http.Client，用法:
+//
+//	rec := tusharetest.NewRecorder("testdata", tusharetest.ModeFromEnv())
+//	cli := tushare.New(os.Getenv("TUSHARE_TOKEN"),
+//		tushare.WithHTTPClient(rec.HTTPClient()),
+//		tushare.WithRetryPolicy(tushare.NoRetry))
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Fixture 录制文件的内容
+type Fixture struct {
+	API      string          `json:"api_name"`
+	Params   json.RawMessage `json:"params"`
+	Fields   string          `json:"fields"`
+	Status   int             `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Body     string          `json:"body,omitempty"` // 响应不是JSON时的原始内容
+}
+
+type request struct {
+	API    string          `json:"api_name"`
+	Token  string          `json:"token"`
+	Params json.RawMessage `json:"params"`
+	Fields string          `json:"fields"`
+}
+
+// RoundTrip 实现http.RoundTripper接口
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var payload request
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("tusharetest: decode request: %w", err)
+	}
+	params, err := canonical(payload.Params)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(r.Dir, Key(payload.API, params, payload.Fields)+".json")
+
+	if r.Mode != Record {
+		f, err := r.load(path)
+		if err == nil {
+			return f.response(req), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, tushare.Permanent(err)
+		}
+		if r.Mode == Replay {
+			return nil, tushare.Permanent(fmt.Errorf("%w: %s", ErrNotRecorded, path))
+		}
+	}
+
+	tr := r.Transport
+	if tr == nil {
+		tr = http.DefaultTransport
+	}
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+	resp, err := tr.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	f := Fixture{
+		API:    payload.API,
+		Params: params,
+		Fields: payload.Fields,
+		Status: resp.StatusCode,
+	}
+	if json.Valid(data) {
+		f.Response = data
+	} else {
+		f.Body = string(data)
+	}
+	if err := r.save(path, f); err != nil {
+		return nil, err
+	}
+	return f.response(req), nil
+}
+
+// Key 根据接口名称、参数及字段计算录制文件名(不含扩展名)
+func Key(api string, params json.RawMessage, fields string) string {
+	h := sha256.New()
+	h.Write([]byte(api))
+	h.Write([]byte{0})
+	h.Write(params)
+	h.Write([]byte{0})
+	h.Write([]byte(fields))
+	return api + "_" + hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// canonical 重新序列化参数，使key的顺序固定
+func canonical(params json.RawMessage) (json.RawMessage, error) {
+	if len(params) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+	var v any
+	if err := json.Unmarshal(params, &v); err != nil {
+		return nil, fmt.Errorf("tusharetest: decode params: %w", err)
+	}
+	return json.Marshal(v)
+}
+
+func (r *Recorder) load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f Fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("tusharetest: decode %s: %w", path, err)
+	}
+	return &f, nil
+}
+
+func (r *Recorder) save(path string, f Fixture) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func (f *Fixture) response(req *http.Request) *http.Response {
+	status := f.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	body := []byte(f.Response)
+	if len(body) == 0 {
+		body = []byte(f.Body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
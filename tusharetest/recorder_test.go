@@ -0,0 +1,114 @@
+package tusharetest_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+const testToken = "secret-token-for-test"
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, tushare.Shanghai)
+}
+
+func tick(code string, d int, close float64) tushare.DailyTick {
+	var t tushare.DailyTick
+	t.Code, t.Time = code, day(d)
+	t.Open, t.High, t.Low, t.Close = close, close+0.1, close-0.1, close
+	t.Volume, t.Turnover = 1000, 1000*close/10
+	return t
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	upstream := tusharetest.NewServer()
+	upstream.SetToken(testToken)
+	upstream.LoadDaily("daily", []tushare.DailyTick{
+		tick("000001.SZ", 2, 9.21),
+		tick("000001.SZ", 3, 9.15),
+		tick("600000.SH", 2, 6.60),
+	})
+	dir := t.TempDir()
+
+	rec := tusharetest.NewRecorder(dir, tusharetest.Record)
+	cli := tushare.New(testToken,
+		tushare.WithBaseURL(upstream.URL),
+		tushare.WithHTTPClient(rec.HTTPClient()))
+	recorded, err := cli.Daily(tushare.WithDailyCode("000001.SZ"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded) != 2 {
+		t.Fatalf("recorded %d rows, want 2", len(recorded))
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || !strings.HasPrefix(filepath.Base(files[0]), "daily_") {
+		t.Fatalf("fixtures = %v, want one daily fixture", files)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), testToken) {
+		t.Fatalf("fixture contains the token:\n%s", data)
+	}
+
+	// 关闭上游服务器后仅从文件回放
+	upstream.Close()
+	replay := tushare.New(testToken,
+		tushare.WithBaseURL(upstream.URL),
+		tushare.WithHTTPClient(tusharetest.NewRecorder(dir, tusharetest.Replay).HTTPClient()))
+	replayed, err := replay.Daily(tushare.WithDailyCode("000001.SZ"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(recorded, replayed) {
+		t.Fatalf("replayed rows differ:\n got %+v\nwant %+v", replayed, recorded)
+	}
+}
+
+func TestRecorderReplayMissFailsFast(t *testing.T) {
+	// 使用默认的重试策略，未录制的请求不应重试
+	cli := tushare.New(testToken,
+		tushare.WithHTTPClient(tusharetest.NewRecorder(t.TempDir(), tusharetest.Replay).HTTPClient()))
+	start := time.Now()
+	_, err := cli.Daily(tushare.WithDailyCode("000001.SZ"))
+	if !errors.Is(err, tusharetest.ErrNotRecorded) {
+		t.Fatalf("err = %v, want ErrNotRecorded", err)
+	}
+	var retryErr *tushare.RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 1 {
+		t.Fatalf("err = %#v, want a single attempt", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("replay miss took %v", d)
+	}
+}
+
+func TestRecorderReplayOrRecord(t *testing.T) {
+	upstream := tusharetest.NewServer()
+	defer upstream.Close()
+	upstream.LoadDaily("daily", []tushare.DailyTick{tick("000001.SZ", 2, 9.21)})
+	cli := tushare.New(testToken,
+		tushare.WithBaseURL(upstream.URL),
+		tushare.WithHTTPClient(tusharetest.NewRecorder(t.TempDir(), tusharetest.ReplayOrRecord).HTTPClient()))
+	for range 3 {
+		if _, err := cli.Daily(tushare.WithDailyCode("000001.SZ")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := upstream.Calls("daily"); n != 1 {
+		t.Fatalf("upstream called %d times, want 1", n)
+	}
+}
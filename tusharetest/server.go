@@ -0,0 +1,371 @@
+package tusharetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lwch/tushare"
+)
+
+// Server 实现tushare接口协议的测试服务器，可预先写入数据并按参数过滤，
+// 支持注入错误码、频率限制及分页截断，用法:
+//
+//	srv := tusharetest.NewServer()
+//	defer srv.Close()
+//	srv.LoadDaily("daily", ticks)
+//	cli := tushare.New("", tushare.WithBaseURL(srv.URL))
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	token    string
+	tables   map[string]*table
+	pageSize map[string]int
+	failures map[string][]failure
+	limits   map[string]*rateLimit
+	calls    map[string]int
+}
+
+type table struct {
+	fields     []string
+	rows       [][]any
+	dateColumn string
+}
+
+type failure struct {
+	status int
+	code   int
+	msg    string
+}
+
+type rateLimit struct {
+	perMinute int
+	calls     []time.Time
+}
+
+// NewServer 创建并启动测试服务器
+func NewServer() *Server {
+	s := &Server{
+		tables:   make(map[string]*table),
+		pageSize: make(map[string]int),
+		failures: make(map[string][]failure),
+		limits:   make(map[string]*rateLimit),
+		calls:    make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetToken 设置有效的token，为空时不校验token
+func (s *Server) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
+
+// AddRows 写入指定接口的数据，多次写入时追加，fields需与已有数据一致，
+// start_date及end_date参数按trade_date、cal_date、ann_date中首个存在的列过滤
+func (s *Server) AddRows(api string, fields []string, rows [][]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tables[api]
+	if !ok {
+		t = &table{fields: slices.Clone(fields)}
+		for _, column := range []string{"trade_date", "cal_date", "ann_date"} {
+			if slices.Contains(fields, column) {
+				t.dateColumn = column
+				break
+			}
+		}
+		s.tables[api] = t
+	}
+	for _, row := range rows {
+		item := make([]any, len(t.fields))
+		for i, field := range fields {
+			if j := slices.Index(t.fields, field); j >= 0 && i < len(row) {
+				item[j] = row[i]
+			}
+		}
+		t.rows = append(t.rows, item)
+	}
+}
+
+// SetDateColumn 设置start_date及end_date参数过滤的列
+func (s *Server) SetDateColumn(api, column string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.tables[api]; ok {
+		t.dateColumn = column
+	}
+}
+
+// LoadDaily 写入日线数据，api可以为daily、fund_daily、index_daily等
+func (s *Server) LoadDaily(api string, ticks []tushare.DailyTick) {
+	rows := make([][]any, len(ticks))
+	for i, t := range ticks {
+		rows[i] = []any{
+			t.Code, formatDate(t.Time),
+			t.Open, t.High, t.Low, t.Close,
+			t.PreClose, t.Change, t.PctChg,
+			t.Volume, t.Turnover,
+		}
+	}
+	s.AddRows(api, []string{
+		"ts_code", "trade_date",
+		"open", "high", "low", "close",
+		"pre_close", "change", "pct_chg",
+		"vol", "amount"}, rows)
+}
+
+// LoadAdjFactor 写入复权因子，api可以为adj_factor或fund_adj
+func (s *Server) LoadAdjFactor(api string, factors []tushare.Adjust) {
+	rows := make([][]any, len(factors))
+	for i, f := range factors {
+		rows[i] = []any{f.Code, formatDate(f.Date), f.Factor}
+	}
+	s.AddRows(api, []string{"ts_code", "trade_date", "adj_factor"}, rows)
+}
+
+// LoadTradeCal 写入交易日历，生成首个至最后一个交易日之间的所有自然日
+func (s *Server) LoadTradeCal(exchange string, open []time.Time) {
+	if len(open) == 0 {
+		return
+	}
+	days := make(map[string]bool, len(open))
+	for _, t := range open {
+		days[formatDate(t)] = true
+	}
+	first := slices.MinFunc(open, time.Time.Compare).In(tushare.Shanghai)
+	last := slices.MaxFunc(open, time.Time.Compare).In(tushare.Shanghai)
+	var rows [][]any
+	var pre any
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		date := formatDate(d)
+		isOpen := 0.
+		if days[date] {
+			isOpen = 1
+		}
+		rows = append(rows, []any{exchange, date, isOpen, pre})
+		if days[date] {
+			pre = date
+		}
+	}
+	s.AddRows("trade_cal", []string{"exchange", "cal_date", "is_open", "pretrade_date"}, rows)
+}
+
+// LoadIndexWeight 写入指数成分股权重
+func (s *Server) LoadIndexWeight(indexCode string, weights []tushare.IndexWeight) {
+	rows := make([][]any, len(weights))
+	for i, w := range weights {
+		rows[i] = []any{indexCode, w.Code, formatDate(w.Date), w.Weight}
+	}
+	s.AddRows("index_weight", []string{"index_code", "con_code", "trade_date", "weight"}, rows)
+}
+
+// SetPageSize 设置接口单次返回的最大行数，超出时返回has_more
+func (s *Server) SetPageSize(api string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pageSize[api] = n
+}
+
+// FailNext 接下来的n次请求返回指定的错误码
+func (s *Server) FailNext(api string, n int, code int, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for range n {
+		s.failures[api] = append(s.failures[api], failure{code: code, msg: msg})
+	}
+}
+
+// FailNextHTTP 接下来的n次请求返回指定的HTTP状态码
+func (s *Server) FailNextHTTP(api string, n int, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for range n {
+		s.failures[api] = append(s.failures[api], failure{status: status})
+	}
+}
+
+// SetRateLimit 设置接口每分钟最大请求次数，超出时返回40203错误
+func (s *Server) SetRateLimit(api string, perMinute int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limits[api] = &rateLimit{perMinute: perMinute}
+}
+
+// Calls 返回接口被请求的次数
+func (s *Server) Calls(api string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[api]
+}
+
+type response struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data *data  `json:"data"`
+}
+
+type data struct {
+	Fields  []string `json:"fields"`
+	Items   [][]any  `json:"items"`
+	HasMore bool     `json:"has_more"`
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		API    string         `json:"api_name"`
+		Token  string         `json:"token"`
+		Params map[string]any `json:"params"`
+		Fields string         `json:"fields"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[req.API]++
+	reply := func(resp response) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}
+	if s.token != "" && req.Token != s.token {
+		reply(response{Code: 40101, Msg: "您的token不对，请确认。"})
+		return
+	}
+	if l, ok := s.limits[req.API]; ok {
+		now := time.Now()
+		l.calls = slices.DeleteFunc(l.calls, func(t time.Time) bool {
+			return now.Sub(t) >= time.Minute
+		})
+		if len(l.calls) >= l.perMinute {
+			reply(response{Code: 40203, Msg: fmt.Sprintf("抱歉，您每分钟最多访问该接口%d次", l.perMinute)})
+			return
+		}
+		l.calls = append(l.calls, now)
+	}
+	if fs := s.failures[req.API]; len(fs) > 0 {
+		f := fs[0]
+		s.failures[req.API] = fs[1:]
+		if f.status != 0 {
+			http.Error(w, http.StatusText(f.status), f.status)
+			return
+		}
+		reply(response{Code: f.code, Msg: f.msg})
+		return
+	}
+	t, ok := s.tables[req.API]
+	if !ok {
+		reply(response{Code: -2001, Msg: "请指定正确的接口名"})
+		return
+	}
+	reply(response{Data: s.query(t, req.API, req.Params, req.Fields)})
+}
+
+// query 按参数过滤数据，每个与列同名的参数按逗号分隔的列表匹配
+func (s *Server) query(t *table, api string, params map[string]any, fields string) *data {
+	var columns []int
+	var names []string
+	for _, field := range strings.Split(fields, ",") {
+		if i := slices.Index(t.fields, strings.TrimSpace(field)); i >= 0 {
+			columns = append(columns, i)
+			names = append(names, t.fields[i])
+		}
+	}
+	if len(columns) == 0 {
+		for i, field := range t.fields {
+			columns = append(columns, i)
+			names = append(names, field)
+		}
+	}
+	start, end := param(params, "start_date"), param(params, "end_date")
+	dateIdx := slices.Index(t.fields, t.dateColumn)
+	var rows [][]any
+	for _, row := range t.rows {
+		if !match(t, row, params) {
+			continue
+		}
+		if dateIdx >= 0 {
+			date := value(row[dateIdx])
+			if (start != "" && date < start) || (end != "" && date > end) {
+				continue
+			}
+		}
+		item := make([]any, len(columns))
+		for i, j := range columns {
+			item[i] = row[j]
+		}
+		rows = append(rows, item)
+	}
+	offset, _ := strconv.Atoi(param(params, "offset"))
+	offset = min(max(offset, 0), len(rows))
+	rows = rows[offset:]
+	n := len(rows)
+	if limit, _ := strconv.Atoi(param(params, "limit")); limit > 0 {
+		n = min(n, limit)
+	}
+	hasMore := false
+	if size := s.pageSize[api]; size > 0 && n > size {
+		n = size
+		hasMore = true
+	}
+	return &data{Fields: names, Items: rows[:n], HasMore: hasMore}
+}
+
+func match(t *table, row []any, params map[string]any) bool {
+	for key := range params {
+		switch key {
+		case "start_date", "end_date", "limit", "offset":
+			continue
+		}
+		idx := slices.Index(t.fields, key)
+		if idx < 0 {
+			continue
+		}
+		want := param(params, key)
+		if want == "" {
+			continue
+		}
+		if !slices.Contains(strings.Split(want, ","), value(row[idx])) {
+			return false
+		}
+	}
+	return true
+}
+
+func param(params map[string]any, key string) string {
+	v, ok := params[key]
+	if !ok {
+		return ""
+	}
+	return value(v)
+}
+
+func value(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(tushare.Shanghai).Format("20060102")
+}
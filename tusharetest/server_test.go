@@ -0,0 +1,221 @@
+package tusharetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+// fastRetry 与默认策略的错误分类相同，但不等待
+var fastRetry = tushare.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+func newServer(t *testing.T, n int) *tusharetest.Server {
+	t.Helper()
+	srv := tusharetest.NewServer()
+	t.Cleanup(srv.Close)
+	ticks := make([]tushare.DailyTick, n)
+	for i := range ticks {
+		ticks[i] = tick("000001.SZ", i+2, 9+float64(i)/10)
+	}
+	srv.LoadDaily("daily", ticks)
+	return srv
+}
+
+func TestServerRateLimitRetried(t *testing.T) {
+	srv := newServer(t, 1)
+	srv.FailNext("daily", 2, 40203, "抱歉，您每分钟最多访问该接口500次")
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(fastRetry))
+	ticks, err := cli.Daily()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 1 {
+		t.Fatalf("got %d rows, want 1", len(ticks))
+	}
+	if n := srv.Calls("daily"); n != 3 {
+		t.Fatalf("calls = %d, want 3", n)
+	}
+}
+
+func TestServerNoPermissionNotRetried(t *testing.T) {
+	srv := newServer(t, 1)
+	srv.FailNext("daily", 1, 40203, "抱歉，您没有访问该接口的权限")
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(fastRetry))
+	_, err := cli.Daily()
+	if !errors.Is(err, tushare.ErrNoPermission) {
+		t.Fatalf("err = %v, want ErrNoPermission", err)
+	}
+	var retryErr *tushare.RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 1 {
+		t.Fatalf("err = %#v, want a single attempt", err)
+	}
+	if n := srv.Calls("daily"); n != 1 {
+		t.Fatalf("calls = %d, want 1", n)
+	}
+}
+
+func TestServerHTTPErrorRetried(t *testing.T) {
+	srv := newServer(t, 1)
+	srv.FailNextHTTP("daily", 1, http.StatusBadGateway)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(fastRetry))
+	if _, err := cli.Daily(); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Calls("daily"); n != 2 {
+		t.Fatalf("calls = %d, want 2", n)
+	}
+}
+
+func TestServerSetRateLimit(t *testing.T) {
+	srv := newServer(t, 1)
+	srv.SetRateLimit("daily", 1)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(tushare.NoRetry))
+	if _, err := cli.Daily(); err != nil {
+		t.Fatal(err)
+	}
+	_, err := cli.Daily()
+	if !errors.Is(err, tushare.ErrRateLimited) {
+		t.Fatalf("err = %v, want ErrRateLimited", err)
+	}
+}
+
+func TestServerPaging(t *testing.T) {
+	srv := newServer(t, 5)
+	srv.SetPageSize("daily", 2)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(tushare.NoRetry))
+	ticks, err := cli.Daily()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 5 {
+		t.Fatalf("got %d rows, want 5", len(ticks))
+	}
+	for i, tick := range ticks {
+		if !tick.Time.Equal(day(i + 2)) {
+			t.Fatalf("row %d: date = %v", i, tick.Time)
+		}
+	}
+	if n := srv.Calls("daily"); n != 3 {
+		t.Fatalf("calls = %d, want 3", n)
+	}
+}
+
+func TestServerLimit(t *testing.T) {
+	srv := newServer(t, 5)
+	srv.SetPageSize("daily", 2)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(tushare.NoRetry))
+	ticks, err := cli.Daily(tushare.WithLimit(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 3 {
+		t.Fatalf("got %d rows, want 3", len(ticks))
+	}
+	if n := srv.Calls("daily"); n != 2 {
+		t.Fatalf("calls = %d, want 2", n)
+	}
+}
+
+func TestServerIterStops(t *testing.T) {
+	srv := newServer(t, 5)
+	srv.SetPageSize("daily", 2)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(tushare.NoRetry))
+	var got int
+	for _, err := range cli.DailyIter() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got++
+		break
+	}
+	if got != 1 {
+		t.Fatalf("got %d rows, want 1", got)
+	}
+	if n := srv.Calls("daily"); n != 1 {
+		t.Fatalf("calls = %d, want 1", n)
+	}
+}
+
+func TestServerContextStopsRetrySleep(t *testing.T) {
+	srv := newServer(t, 1)
+	srv.FailNext("daily", 3, 40203, "抱歉，您每分钟最多访问该接口500次")
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithRetryPolicy(tushare.RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Minute,
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	_, err := cli.DailyContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if d := time.Since(begin); d > 5*time.Second {
+		t.Fatalf("returned after %v", d)
+	}
+	if n := srv.Calls("daily"); n != 1 {
+		t.Fatalf("calls = %d, want 1", n)
+	}
+}
+
+func TestServerContextStopsRequest(t *testing.T) {
+	// 服务器在客户端断开前不返回
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithTimeout(0), tushare.WithRetryPolicy(fastRetry))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	_, err := cli.DailyContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if d := time.Since(begin); d > 5*time.Second {
+		t.Fatalf("returned after %v", d)
+	}
+	var retryErr *tushare.RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 1 {
+		t.Fatalf("err = %#v, want a single attempt", err)
+	}
+}
+
+func TestServerLocation(t *testing.T) {
+	srv := tusharetest.NewServer()
+	defer srv.Close()
+	srv.AddRows("daily", []string{"ts_code", "trade_date", "close"}, [][]any{
+		{"000001.SZ", "20240102", 9.39},
+		{"000001.SZ", "20240103", 9.2},
+	})
+	// UTC的1月2日20点在北京时间为1月3日
+	at := time.Date(2024, 1, 2, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		loc  *time.Location
+		want time.Time
+	}{
+		{time.UTC, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{tushare.Shanghai, time.Date(2024, 1, 3, 0, 0, 0, 0, tushare.Shanghai)},
+	}
+	for _, tt := range tests {
+		cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithLocation(tt.loc))
+		ticks, err := cli.Daily(tushare.WithDailyDate(at))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ticks) != 1 {
+			t.Fatalf("%v: got %d rows, want 1", tt.loc, len(ticks))
+		}
+		if got := ticks[0].Time; !got.Equal(tt.want) || got.Location() != tt.loc {
+			t.Errorf("%v: date = %v, want %v", tt.loc, got, tt.want)
+		}
+	}
+}
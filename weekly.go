@@ -0,0 +1,51 @@
+// https://tushare.pro/document/2?doc_id=144
+// https://tushare.pro/document/2?doc_id=145
+// https://tushare.pro/document/2?doc_id=336
+
+package tushare
+
+import "context"
+
+// Weekly 获取周线数据，参数与Daily相同
+func (cli *Client) Weekly(opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.WeeklyContext(context.Background(), opts...)
+}
+
+// WeeklyContext 获取周线数据，参数与Daily相同
+func (cli *Client) WeeklyContext(ctx context.Context, opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.daily(ctx, "weekly", opts...)
+}
+
+// Monthly 获取月线数据，参数与Daily相同
+func (cli *Client) Monthly(opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.MonthlyContext(context.Background(), opts...)
+}
+
+// MonthlyContext 获取月线数据，参数与Daily相同
+func (cli *Client) MonthlyContext(ctx context.Context, opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.daily(ctx, "monthly", opts...)
+}
+
+type weeklyMonthlyFreq string
+
+const (
+	WeeklyMonthlyFreqWeek  weeklyMonthlyFreq = "week"  // 周线
+	WeeklyMonthlyFreqMonth weeklyMonthlyFreq = "month" // 月线
+)
+
+// StkWeeklyMonthly 获取每日更新的周/月线数据，未结束的周期以最近一个交易日计算，参数与Daily相同，
+// 返回的数据为不复权价格，不支持复权的周/月线接口，
+// 复权的周/月线可使用AdjustedDaily获取复权后的日线再通过Resample合成
+func (cli *Client) StkWeeklyMonthly(freq weeklyMonthlyFreq, opts ...dailyOpt) ([]DailyTick, error) {
+	return cli.StkWeeklyMonthlyContext(context.Background(), freq, opts...)
+}
+
+// StkWeeklyMonthlyContext 获取每日更新的周/月线数据，未结束的周期以最近一个交易日计算，参数与Daily相同，
+// 返回的数据为不复权价格，复权的周/月线可使用AdjustedDaily获取复权后的日线再通过Resample合成
+func (cli *Client) StkWeeklyMonthlyContext(ctx context.Context, freq weeklyMonthlyFreq, opts ...dailyOpt) ([]DailyTick, error) {
+	args := Args{"freq": freq}
+	for _, o := range opts {
+		o(args)
+	}
+	return query[DailyTick](ctx, cli, "stk_weekly_monthly", args, dailyFields)
+}
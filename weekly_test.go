@@ -0,0 +1,78 @@
+package tushare_test
+
+import (
+	"testing"
+
+	"github.com/lwch/tushare"
+	"github.com/lwch/tushare/tusharetest"
+)
+
+func TestWeeklyMonthly(t *testing.T) {
+	srv := tusharetest.NewServer()
+	defer srv.Close()
+	srv.LoadDaily("weekly", []tushare.DailyTick{
+		barTick("000001.SZ", 5, 9.27, 5000),
+		barTick("000001.SZ", 12, 9.3, 6000),
+		barTick("600000.SH", 12, 6.6, 7000),
+	})
+	srv.LoadDaily("monthly", []tushare.DailyTick{barTick("000001.SZ", 31, 9.21, 30000)})
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithStrictDecode())
+
+	ticks, err := cli.Weekly(tushare.WithDailyCode("000001.SZ"), tushare.WithDailyDateRange(date(2024, 1, 1), date(2024, 1, 7)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 1 || ticks[0].Close != 9.27 || !ticks[0].Time.Equal(date(2024, 1, 5)) || ticks[0].Volume != 5000 {
+		t.Fatalf("unexpected rows %+v", ticks)
+	}
+	ticks, err = cli.Monthly(tushare.WithDailyCode("000001.SZ"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 1 || ticks[0].Close != 9.21 || !ticks[0].Time.Equal(date(2024, 1, 31)) {
+		t.Fatalf("unexpected rows %+v", ticks)
+	}
+	if srv.Calls("weekly") != 1 || srv.Calls("monthly") != 1 {
+		t.Fatalf("calls = %d, %d", srv.Calls("weekly"), srv.Calls("monthly"))
+	}
+}
+
+func TestStkWeeklyMonthly(t *testing.T) {
+	srv := tusharetest.NewServer()
+	defer srv.Close()
+	srv.AddRows("stk_weekly_monthly", []string{
+		"ts_code", "trade_date", "freq",
+		"open", "high", "low", "close",
+		"pre_close", "change", "pct_chg",
+		"vol", "amount",
+	}, [][]any{
+		// 未结束的一周以最近一个交易日(周三)为日期
+		{"000001.SZ", "20240110", "week", 9.3, 9.4, 9.2, 9.35, 9.27, 0.08, 0.863, 3000., 2800.},
+		{"000001.SZ", "20240110", "month", 9.39, 9.5, 9.2, 9.35, 9.39, -0.04, -0.426, 9000., 8400.},
+	})
+	cli := tushare.New("", tushare.WithBaseURL(srv.URL), tushare.WithStrictDecode())
+	tests := []struct {
+		freq  string
+		close float64
+		pct   float64
+	}{
+		{"week", 9.35, 0.863},
+		{"month", 9.35, -0.426},
+	}
+	for _, tt := range tests {
+		freq := tushare.WeeklyMonthlyFreqWeek
+		if tt.freq == "month" {
+			freq = tushare.WeeklyMonthlyFreqMonth
+		}
+		ticks, err := cli.StkWeeklyMonthly(freq, tushare.WithDailyCode("000001.SZ"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ticks) != 1 {
+			t.Fatalf("%s: got %d rows, want 1", tt.freq, len(ticks))
+		}
+		if got := ticks[0]; got.Close != tt.close || got.PctChg != tt.pct || !got.Time.Equal(date(2024, 1, 10)) {
+			t.Fatalf("%s: unexpected row %+v", tt.freq, got)
+		}
+	}
+}